@@ -0,0 +1,306 @@
+package golog
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// OverflowPolicy decides what an AsyncDriver does with an entry when its queue is full
+type OverflowPolicy string
+
+const (
+	// OverflowBlock waits until the queue has room
+	OverflowBlock OverflowPolicy = "block"
+
+	// OverflowDropNewest drops the entry being logged
+	OverflowDropNewest OverflowPolicy = "drop_newest"
+
+	// OverflowDropOldest drops the oldest queued entry to make room
+	OverflowDropOldest OverflowPolicy = "drop_oldest"
+
+	// OverflowDropBelowLevel drops entries below AsyncConfig.OverflowLevel
+	// and waits for room for the others
+	OverflowDropBelowLevel OverflowPolicy = "drop_below_level"
+)
+
+// valid reports whether p is a known policy; empty selects OverflowBlock
+func (p OverflowPolicy) valid() bool {
+	switch p {
+	case "", OverflowBlock, OverflowDropNewest, OverflowDropOldest, OverflowDropBelowLevel:
+		return true
+	default:
+		return false
+	}
+}
+
+const (
+	// defaultAsyncDrainTimeout is how long Close waits for queued entries by default
+	defaultAsyncDrainTimeout = 5 * time.Second
+
+	// defaultOverflowLevel is the level from which drop_below_level keeps entries
+	defaultOverflowLevel = WarningLevel
+)
+
+// errAsyncClosed is returned for entries logged to a closed AsyncDriver
+var errAsyncClosed = errors.New("async driver is closed")
+
+// AsyncDriver writes entries to another driver in the background. Entries wait
+// in a bounded queue drained by a fixed number of workers; what happens when
+// the queue is full is set by the OverflowPolicy. Write errors are reported
+// through SetErrorCallback.
+type AsyncDriver struct {
+	driver        Driver
+	queue         chan *Entry
+	overflow      OverflowPolicy
+	overflowLevel Level
+	drainTimeout  time.Duration
+
+	// mu is held for reading while enqueuing so Close can close the queue
+	mu       sync.RWMutex
+	closed   bool
+	stop     chan struct{}
+	stopOnce sync.Once
+	workers  sync.WaitGroup
+
+	// abandoned is set when Close gives up draining; workers then drop entries
+	abandoned atomic.Bool
+	dropped   atomic.Uint64
+
+	onError func(entry *Entry, err error)
+
+	// onDrop is called for every dropped entry (set by the Manager)
+	onDrop func(entry *Entry)
+}
+
+// NewAsyncDriver wraps driver so entries are written in the background and
+// starts the workers. Closing the AsyncDriver closes driver.
+func NewAsyncDriver(driver Driver, config AsyncConfig) (*AsyncDriver, error) {
+	if config.QueueSize <= 0 {
+		return nil, fmt.Errorf("async queue size must be positive")
+	}
+
+	overflow := config.Overflow
+	if overflow == "" {
+		overflow = OverflowBlock
+	}
+	if !overflow.valid() {
+		return nil, fmt.Errorf("overflow policy [%s] is not supported", overflow)
+	}
+
+	overflowLevel := defaultOverflowLevel
+	if config.OverflowLevel != "" {
+		level, err := parseLevel(config.OverflowLevel)
+		if err != nil {
+			return nil, fmt.Errorf("overflow_level: %w", err)
+		}
+		overflowLevel = level
+	}
+
+	workers := config.Workers
+	if workers <= 0 {
+		workers = 1
+	}
+
+	drainTimeout := config.DrainTimeout
+	if drainTimeout <= 0 {
+		drainTimeout = defaultAsyncDrainTimeout
+	}
+
+	d := &AsyncDriver{
+		driver:        driver,
+		queue:         make(chan *Entry, config.QueueSize),
+		overflow:      overflow,
+		overflowLevel: overflowLevel,
+		drainTimeout:  drainTimeout,
+		stop:          make(chan struct{}),
+	}
+
+	d.workers.Add(workers)
+	for i := 0; i < workers; i++ {
+		go d.work()
+	}
+
+	return d, nil
+}
+
+// work writes queued entries until the queue is closed
+func (d *AsyncDriver) work() {
+	defer d.workers.Done()
+
+	for entry := range d.queue {
+		if d.abandoned.Load() {
+			d.drop(entry)
+			continue
+		}
+		if err := d.driver.Log(entry); err != nil && d.onError != nil {
+			d.onError(entry, err)
+		}
+	}
+}
+
+// Log queues the entry, applying the overflow policy when the queue is full.
+// Dropped entries are counted, not returned as errors.
+func (d *AsyncDriver) Log(entry *Entry) error {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if d.closed {
+		return errAsyncClosed
+	}
+
+	select {
+	case d.queue <- entry:
+		return nil
+	default:
+	}
+
+	switch d.overflow {
+	case OverflowDropNewest:
+		d.drop(entry)
+		return nil
+	case OverflowDropOldest:
+		for {
+			select {
+			case oldest := <-d.queue:
+				d.drop(oldest)
+			default:
+			}
+			select {
+			case d.queue <- entry:
+				return nil
+			default:
+			}
+		}
+	case OverflowDropBelowLevel:
+		if entry.Level < d.overflowLevel {
+			d.drop(entry)
+			return nil
+		}
+	}
+
+	select {
+	case d.queue <- entry:
+		return nil
+	case <-d.stop:
+		d.drop(entry)
+		return errAsyncClosed
+	}
+}
+
+// drop counts an entry that will not be written
+func (d *AsyncDriver) drop(entry *Entry) {
+	d.dropped.Add(1)
+	if d.onDrop != nil {
+		d.onDrop(entry)
+	}
+}
+
+// Dropped returns the number of entries dropped because the queue was full
+// or the driver was closed before writing them
+func (d *AsyncDriver) Dropped() uint64 {
+	return d.dropped.Load()
+}
+
+// Pending returns the number of queued entries
+func (d *AsyncDriver) Pending() int {
+	return len(d.queue)
+}
+
+// Close stops accepting entries and waits up to the drain timeout for the
+// queued ones to be written before closing the wrapped driver. If the timeout
+// passes, the remaining entries are dropped and the wrapped driver is closed
+// once the write in progress returns.
+func (d *AsyncDriver) Close() error {
+	// Release writers blocked on a full queue so the lock can be taken
+	d.stopOnce.Do(func() { close(d.stop) })
+
+	d.mu.Lock()
+	if d.closed {
+		d.mu.Unlock()
+		return nil
+	}
+	d.closed = true
+	close(d.queue)
+	d.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		d.workers.Wait()
+		close(done)
+	}()
+
+	timer := time.NewTimer(d.drainTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+		return d.driver.Close()
+	case <-timer.C:
+		d.abandoned.Store(true)
+		pending := len(d.queue)
+		go func() {
+			<-done
+			d.driver.Close()
+		}()
+		return fmt.Errorf("%d queued entries were not written within %s", pending, d.drainTimeout)
+	}
+}
+
+// Reopen reopens the wrapped driver's files if it supports it
+func (d *AsyncDriver) Reopen() error {
+	if r, ok := d.driver.(Reopener); ok {
+		return r.Reopen()
+	}
+	return nil
+}
+
+// SetErrorCallback sets the function receiving errors of background writes,
+// and of the wrapped driver if it reports asynchronous errors itself
+func (d *AsyncDriver) SetErrorCallback(fn func(entry *Entry, err error)) {
+	d.onError = fn
+	if r, ok := d.driver.(AsyncErrorReporter); ok {
+		r.SetErrorCallback(fn)
+	}
+}
+
+// Name returns the wrapped driver's name
+func (d *AsyncDriver) Name() string {
+	return d.driver.Name()
+}
+
+// wrapAsync wraps the driver of the named channel in an AsyncDriver if the
+// channel is configured to write asynchronously, closing it on error
+func (m *Manager) wrapAsync(name string, driver Driver, config ChannelConfig) (Driver, error) {
+	if config.AsyncConfig == nil || config.AsyncConfig.QueueSize == 0 {
+		return driver, nil
+	}
+
+	d, err := NewAsyncDriver(driver, *config.AsyncConfig)
+	if err != nil {
+		driver.Close()
+		return nil, err
+	}
+	d.onDrop = func(entry *Entry) {
+		m.failuresMu.Lock()
+		m.dropped[name]++
+		m.failuresMu.Unlock()
+	}
+	return d, nil
+}
+
+// Dropped returns the number of entries each asynchronous channel dropped
+// since the manager was created, because its queue was full or it was closed
+// before writing them. Channels used inside stacks count under their own name.
+func (m *Manager) Dropped() map[string]uint64 {
+	m.failuresMu.Lock()
+	defer m.failuresMu.Unlock()
+
+	dropped := make(map[string]uint64, len(m.dropped))
+	for name, n := range m.dropped {
+		dropped[name] = n
+	}
+	return dropped
+}
@@ -0,0 +1,305 @@
+package golog
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// gatedDriver records messages, holding each write until release is closed,
+// and then fails with err if set
+type gatedDriver struct {
+	mu       sync.Mutex
+	messages []string
+	closed   bool
+	err      error
+	started  chan struct{}
+	release  chan struct{}
+}
+
+func newGatedDriver() *gatedDriver {
+	return &gatedDriver{started: make(chan struct{}, 100), release: make(chan struct{})}
+}
+
+func (d *gatedDriver) Log(entry *Entry) error {
+	d.started <- struct{}{}
+	<-d.release
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.messages = append(d.messages, entry.Message)
+	return d.err
+}
+
+func (d *gatedDriver) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.closed = true
+	return nil
+}
+
+func (d *gatedDriver) Name() string { return "gated" }
+
+func (d *gatedDriver) written() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]string(nil), d.messages...)
+}
+
+// newBusyAsyncDriver returns an async driver whose single worker is stuck
+// writing "busy" and whose queue of size 2 is full
+// registerGatedDriver registers a "gated" driver returning inner
+func registerGatedDriver(t *testing.T, inner *gatedDriver) {
+	t.Helper()
+
+	RegisterDriver("gated", func(config ChannelConfig) (Driver, error) {
+		return inner, nil
+	})
+	t.Cleanup(func() { delete(driverFactories, "gated") })
+}
+
+func newBusyAsyncDriver(t *testing.T, config AsyncConfig) (*AsyncDriver, *gatedDriver) {
+	t.Helper()
+
+	inner := newGatedDriver()
+	config.QueueSize = 2
+	d, err := NewAsyncDriver(inner, config)
+	if err != nil {
+		t.Fatalf("NewAsyncDriver failed: %v", err)
+	}
+
+	d.Log(&Entry{Level: InfoLevel, Message: "busy"})
+	<-inner.started
+	d.Log(&Entry{Level: InfoLevel, Message: "one"})
+	d.Log(&Entry{Level: InfoLevel, Message: "two"})
+
+	return d, inner
+}
+
+func TestAsyncDriver_Close_Drains(t *testing.T) {
+	inner := newGatedDriver()
+	close(inner.release)
+
+	d, err := NewAsyncDriver(inner, AsyncConfig{QueueSize: 10, Workers: 3})
+	if err != nil {
+		t.Fatalf("NewAsyncDriver failed: %v", err)
+	}
+	for i := 0; i < 10; i++ {
+		d.Log(&Entry{Level: InfoLevel, Message: "hello"})
+	}
+
+	if err := d.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if got := len(inner.written()); got != 10 {
+		t.Errorf("Expected 10 entries written, got %d", got)
+	}
+	if !inner.closed {
+		t.Error("Expected wrapped driver to be closed")
+	}
+	if err := d.Log(&Entry{Message: "late"}); err == nil {
+		t.Error("Expected error logging to closed driver")
+	}
+}
+
+func TestAsyncDriver_DropNewest(t *testing.T) {
+	d, inner := newBusyAsyncDriver(t, AsyncConfig{Overflow: OverflowDropNewest})
+
+	if err := d.Log(&Entry{Level: ErrorLevel, Message: "three"}); err != nil {
+		t.Errorf("Expected dropped entry not to be an error, got %v", err)
+	}
+	if d.Dropped() != 1 {
+		t.Errorf("Expected 1 dropped entry, got %d", d.Dropped())
+	}
+
+	close(inner.release)
+	d.Close()
+	if got := strings.Join(inner.written(), ","); got != "busy,one,two" {
+		t.Errorf("Unexpected entries written: %s", got)
+	}
+}
+
+func TestAsyncDriver_DropOldest(t *testing.T) {
+	d, inner := newBusyAsyncDriver(t, AsyncConfig{Overflow: OverflowDropOldest})
+
+	d.Log(&Entry{Level: InfoLevel, Message: "three"})
+	if d.Dropped() != 1 {
+		t.Errorf("Expected 1 dropped entry, got %d", d.Dropped())
+	}
+
+	close(inner.release)
+	d.Close()
+	if got := strings.Join(inner.written(), ","); got != "busy,two,three" {
+		t.Errorf("Unexpected entries written: %s", got)
+	}
+}
+
+func TestAsyncDriver_DropBelowLevel(t *testing.T) {
+	d, inner := newBusyAsyncDriver(t, AsyncConfig{Overflow: OverflowDropBelowLevel, OverflowLevel: "error"})
+
+	d.Log(&Entry{Level: WarningLevel, Message: "dropped"})
+	if d.Dropped() != 1 {
+		t.Errorf("Expected 1 dropped entry, got %d", d.Dropped())
+	}
+
+	logged := make(chan struct{})
+	go func() {
+		d.Log(&Entry{Level: ErrorLevel, Message: "kept"})
+		close(logged)
+	}()
+
+	select {
+	case <-logged:
+		t.Fatal("Expected error entry to wait for room in the queue")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(inner.release)
+	<-logged
+	d.Close()
+	if got := strings.Join(inner.written(), ","); got != "busy,one,two,kept" {
+		t.Errorf("Unexpected entries written: %s", got)
+	}
+}
+
+func TestAsyncDriver_Close_Deadline(t *testing.T) {
+	d, inner := newBusyAsyncDriver(t, AsyncConfig{DrainTimeout: 20 * time.Millisecond})
+
+	err := d.Close()
+	if err == nil || !strings.Contains(err.Error(), "2 queued entries were not written within 20ms") {
+		t.Errorf("Expected drain timeout error, got %v", err)
+	}
+	close(inner.release)
+	waitFor(t, func() bool { return d.Dropped() == 2 })
+	waitFor(t, func() bool {
+		inner.mu.Lock()
+		defer inner.mu.Unlock()
+		return inner.closed
+	})
+	if got := strings.Join(inner.written(), ","); got != "busy" {
+		t.Errorf("Unexpected entries written: %s", got)
+	}
+}
+
+func TestNewAsyncDriver_Errors(t *testing.T) {
+	tests := []struct {
+		config AsyncConfig
+		want   string
+	}{
+		{AsyncConfig{}, "async queue size must be positive"},
+		{AsyncConfig{QueueSize: 1, Overflow: "drop_all"}, "overflow policy [drop_all] is not supported"},
+		{AsyncConfig{QueueSize: 1, OverflowLevel: "loud"}, "overflow_level: level [loud] is not valid"},
+	}
+
+	for _, tt := range tests {
+		_, err := NewAsyncDriver(&mockDriver{}, tt.config)
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("Expected error %q, got %v", tt.want, err)
+		}
+	}
+}
+
+func TestManager_AsyncChannel(t *testing.T) {
+	inner := newGatedDriver()
+	registerGatedDriver(t, inner)
+	registerFailingDriver(t)
+
+	manager, err := NewManager(&Config{
+		Default: "app",
+		Channels: map[string]ChannelConfig{
+			"app": {Driver: "gated", AsyncConfig: &AsyncConfig{QueueSize: 1, Overflow: OverflowDropNewest}},
+			"bad": {Driver: "failing", AsyncConfig: &AsyncConfig{QueueSize: 1}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewManager failed: %v", err)
+	}
+
+	var mu sync.Mutex
+	var handled []*DriverError
+	manager.SetErrorHandler(func(err *DriverError) {
+		mu.Lock()
+		defer mu.Unlock()
+		handled = append(handled, err)
+	})
+
+	logger, _ := manager.Default()
+	logger.Info("busy")
+	<-inner.started
+	logger.Info("queued")
+	logger.Info("dropped")
+
+	if got := manager.Dropped(); got["app"] != 1 {
+		t.Errorf("Expected 1 dropped entry for app, got %v", got)
+	}
+
+	bad, _ := manager.Channel("bad")
+	bad.Error("payment failed")
+	waitFor(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(handled) == 1
+	})
+	if handled[0].Channel != "bad" || handled[0].Entry.Message != "payment failed" {
+		t.Errorf("Unexpected driver error %+v", handled[0])
+	}
+
+	close(inner.release)
+	if err := manager.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if got := strings.Join(inner.written(), ","); got != "busy,queued" {
+		t.Errorf("Expected queued entries written before Close returned, got %s", got)
+	}
+}
+
+func TestManager_Close_FailingAsyncChannel(t *testing.T) {
+	inner := newGatedDriver()
+	inner.err = errors.New("disk full")
+	registerGatedDriver(t, inner)
+
+	manager, err := NewManager(&Config{
+		Default: "app",
+		Channels: map[string]ChannelConfig{
+			"app": {Driver: "gated", AsyncConfig: &AsyncConfig{QueueSize: 1}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewManager failed: %v", err)
+	}
+
+	var mu sync.Mutex
+	handled := 0
+	manager.SetErrorHandler(func(err *DriverError) {
+		mu.Lock()
+		defer mu.Unlock()
+		handled++
+	})
+
+	// Fill the queue and block a writer behind it
+	logger, _ := manager.Default()
+	logger.Info("busy")
+	<-inner.started
+	logger.Info("queued")
+	go logger.Info("blocked")
+	time.Sleep(20 * time.Millisecond)
+
+	closed := make(chan error)
+	go func() { closed <- manager.Close() }()
+	time.Sleep(20 * time.Millisecond)
+	close(inner.release)
+
+	select {
+	case <-closed:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Close did not return")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if handled != 3 {
+		t.Errorf("Expected 3 driver errors, got %d", handled)
+	}
+}
@@ -0,0 +1,77 @@
+package golog
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// packageDir is the source directory of golog, used to skip its own frames
+var packageDir = func() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Dir(file)
+}()
+
+// CallerInfo identifies the code that logged an entry
+type CallerInfo struct {
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+	Function string `json:"function,omitempty"`
+}
+
+// String returns the caller as "file:line (function)"
+func (c *CallerInfo) String() string {
+	if c.Function == "" {
+		return fmt.Sprintf("%s:%d", c.File, c.Line)
+	}
+	return fmt.Sprintf("%s:%d (%s)", c.File, c.Line, c.Function)
+}
+
+// captureCaller returns the first frame outside golog and the standard
+// log and log/slog packages, i.e. the user's call site
+func captureCaller() *CallerInfo {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	for {
+		frame, more := frames.Next()
+		if !isInternalFrame(frame) {
+			return callerFromFrame(frame)
+		}
+		if !more {
+			return nil
+		}
+	}
+}
+
+// callerFromPC returns the caller for a program counter, as recorded by log/slog
+func callerFromPC(pc uintptr) *CallerInfo {
+	frame, _ := runtime.CallersFrames([]uintptr{pc}).Next()
+	if frame.File == "" {
+		return nil
+	}
+	return callerFromFrame(frame)
+}
+
+// callerFromFrame converts a stack frame to CallerInfo
+func callerFromFrame(frame runtime.Frame) *CallerInfo {
+	return &CallerInfo{
+		File:     frame.File,
+		Line:     frame.Line,
+		Function: frame.Function,
+	}
+}
+
+// isInternalFrame reports whether a frame belongs to golog (excluding its
+// tests), the runtime, or the log and log/slog packages that bridge into golog
+func isInternalFrame(frame runtime.Frame) bool {
+	switch {
+	case strings.HasPrefix(frame.Function, "runtime."),
+		strings.HasPrefix(frame.Function, "log."),
+		strings.HasPrefix(frame.Function, "log/slog."):
+		return true
+	}
+	return filepath.Dir(frame.File) == packageDir && !strings.HasSuffix(frame.File, "_test.go")
+}
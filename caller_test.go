@@ -0,0 +1,143 @@
+package golog
+
+import (
+	"errors"
+	"log/slog"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// callerChannels returns a "test" mock channel capturing callers from callerLevel
+func callerChannels(callerLevel string) map[string]ChannelConfig {
+	return map[string]ChannelConfig{
+		"test": {Driver: "mock", Level: "debug", Caller: true, CallerLevel: callerLevel},
+	}
+}
+
+// currentLine returns the line of its call site
+func currentLine() int {
+	_, _, line, _ := runtime.Caller(1)
+	return line
+}
+
+func assertCaller(t *testing.T, entry *Entry, line int) {
+	t.Helper()
+
+	if entry.Caller == nil {
+		t.Fatal("Expected caller to be captured")
+	}
+	if !strings.HasSuffix(entry.Caller.File, "caller_test.go") || entry.Caller.Line != line {
+		t.Errorf("Expected caller caller_test.go:%d, got %s", line, entry.Caller)
+	}
+	if !strings.Contains(entry.Caller.Function, "TestLogger_Caller") {
+		t.Errorf("Expected caller function of the test, got %q", entry.Caller.Function)
+	}
+}
+
+func TestLogger_Caller(t *testing.T) {
+	manager, driver := newMockManager(t, callerChannels(""))
+	logger, _ := manager.Channel("test")
+
+	logger.Info("plain")
+	line := currentLine() - 1
+	assertCaller(t, driver.entries[0], line)
+
+	logger.Warningf("formatted %d", 1)
+	line = currentLine() - 1
+	assertCaller(t, driver.entries[1], line)
+
+	logger.Errorw("kv", "key", "value")
+	line = currentLine() - 1
+	assertCaller(t, driver.entries[2], line)
+
+	logger.Slog().Info("slog")
+	line = currentLine() - 1
+	assertCaller(t, driver.entries[3], line)
+
+	logger.StdLogger(InfoLevel).Print("std")
+	line = currentLine() - 1
+	assertCaller(t, driver.entries[4], line)
+}
+
+func TestLogger_CallerLevel(t *testing.T) {
+	manager, driver := newMockManager(t, callerChannels("error"))
+	logger, _ := manager.Channel("test")
+
+	logger.Info("below")
+	logger.Error("at")
+
+	if driver.entries[0].Caller != nil {
+		t.Error("Expected no caller below CallerLevel")
+	}
+	if driver.entries[1].Caller == nil {
+		t.Error("Expected caller at CallerLevel")
+	}
+}
+
+func TestLogger_CallerDisabled(t *testing.T) {
+	manager, driver := newMockManager(t, map[string]ChannelConfig{"test": {Driver: "mock", Level: "debug"}})
+	logger, _ := manager.Channel("test")
+
+	logger.Error("no caller")
+
+	if driver.entries[0].Caller != nil {
+		t.Error("Expected no caller when capture is disabled")
+	}
+}
+
+func TestLogger_WithExceptionPointsToCallSite(t *testing.T) {
+	manager, driver := newMockManager(t, map[string]ChannelConfig{"test": {Driver: "mock", Level: "debug"}})
+	logger, _ := manager.Channel("test")
+
+	logger.ErrorWithException("failed", errors.New("boom"))
+	line := currentLine() - 1
+
+	ex := driver.entries[0].Exception
+	if !strings.HasSuffix(ex.File, "caller_test.go") || ex.Line != line {
+		t.Errorf("Expected exception at caller_test.go:%d, got %s:%d", line, ex.File, ex.Line)
+	}
+	if len(ex.Trace) == 0 || !strings.Contains(ex.Trace[0], "caller_test.go") {
+		t.Errorf("Expected trace to start at the call site, got %v", ex.Trace)
+	}
+}
+
+func TestSlogHandler_CallerFromRecord(t *testing.T) {
+	manager, driver := newMockManager(t, callerChannels(""))
+	logger, _ := manager.Channel("test")
+
+	slog.New(NewSlogHandler(logger)).Warn("from slog")
+	line := currentLine() - 1
+
+	if driver.entries[0].Caller == nil || driver.entries[0].Caller.Line != line {
+		t.Errorf("Expected caller line %d, got %v", line, driver.entries[0].Caller)
+	}
+}
+
+func TestFormatters_RenderCaller(t *testing.T) {
+	entry := NewEntry(InfoLevel, "hello")
+	entry.Caller = &CallerInfo{File: "/app/main.go", Line: 42, Function: "main.main"}
+
+	tests := []struct {
+		name      string
+		formatter Formatter
+		want      string
+	}{
+		{"line", NewLineFormatter(""), "Caller: /app/main.go:42 (main.main)"},
+		{"template", &LineFormatter{Template: "%message% at %caller%"}, "hello at /app/main.go:42"},
+		{"json", NewJSONFormatter(""), `"caller":{"file":"/app/main.go","line":42,"function":"main.main"}`},
+		{"logfmt", NewLogfmtFormatter(""), "caller=/app/main.go:42 func=main.main"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := tt.formatter.Format(entry)
+			if err != nil {
+				t.Fatalf("Format failed: %v", err)
+			}
+			if !strings.Contains(string(out), tt.want) {
+				t.Errorf("Expected %q in %q", tt.want, out)
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package golog
 
-import "time"
+import (
+	"crypto/tls"
+	"time"
+)
 
 // Config is the main configuration for the log manager
 type Config struct {
@@ -12,16 +15,43 @@ type Config struct {
 
 	// AppName is the application name (used in Slack messages)
 	AppName string `json:"app_name" yaml:"app_name"`
+
+	// FallbackChannel receives entries that another channel's driver failed to write
+	FallbackChannel string `json:"fallback_channel" yaml:"fallback_channel"`
 }
 
 // ChannelConfig represents configuration for a single logging channel
 type ChannelConfig struct {
-	// Driver is the type of driver: "file", "slack", "stack"
+	// Driver is the type of driver: "file", "daily", "slack", "stdout", "stderr", "syslog", "rfc5424", "stack", "fallback"
 	Driver string `json:"driver" yaml:"driver"`
 
 	// Level is the minimum log level for this channel
 	Level string `json:"level" yaml:"level"`
 
+	// Formatter is the output format for byte-oriented drivers: "line" (default), "json", "logfmt"
+	Formatter string `json:"formatter" yaml:"formatter"`
+
+	// AppName overrides Config.AppName for this channel
+	AppName string `json:"app_name" yaml:"app_name"`
+
+	// ReplacePlaceholders interpolates {key} placeholders in messages from the context
+	ReplacePlaceholders bool `json:"replace_placeholders" yaml:"replace_placeholders"`
+
+	// Caller records the file, line and function of the logging call on each entry
+	Caller bool `json:"caller" yaml:"caller"`
+
+	// CallerLevel limits caller capture to entries at or above this level (default: all levels)
+	CallerLevel string `json:"caller_level" yaml:"caller_level"`
+
+	// Processors lists registered processors (see RegisterProcessor) run for this channel
+	Processors []string `json:"processors" yaml:"processors"`
+
+	// Tap contains processors run for this channel after the named ones (like Laravel's tap)
+	Tap []Processor `json:"-" yaml:"-"`
+
+	// FormatterConfig contains options for the line formatter
+	*FormatterConfig `json:",inline" yaml:",inline"`
+
 	// FileConfig contains file-specific configuration
 	*FileConfig `json:",inline" yaml:",inline"`
 
@@ -30,6 +60,17 @@ type ChannelConfig struct {
 
 	// StackConfig contains stack-specific configuration (for combining channels)
 	*StackConfig `json:",inline" yaml:",inline"`
+
+	// SyslogConfig contains syslog-specific configuration
+	*SyslogConfig `json:",inline" yaml:",inline"`
+
+	// FallbackConfig contains fallback-specific configuration; the ordered
+	// channel list is StackConfig.Channels ("channels")
+	*FallbackConfig `json:",inline" yaml:",inline"`
+
+	// AsyncConfig writes the channel's entries in the background through a
+	// bounded queue (see AsyncDriver); nil writes synchronously
+	*AsyncConfig `json:",inline" yaml:",inline"`
 }
 
 // FileConfig contains configuration for the file driver
@@ -54,6 +95,25 @@ type FileConfig struct {
 
 	// DateFormat is the date format for log entries
 	DateFormat string `json:"date_format" yaml:"date_format"`
+
+	// Days is the number of daily files to keep for the daily driver (0 = keep all)
+	Days int `json:"days" yaml:"days"`
+
+	// Timezone is the IANA time zone in which the daily driver switches files (default: local)
+	Timezone string `json:"timezone" yaml:"timezone"`
+}
+
+// FormatterConfig contains configuration for the line formatter
+type FormatterConfig struct {
+	// LineFormat is a Monolog-style template such as MonologLineFormat
+	// (empty = Laravel multi-line layout)
+	LineFormat string `json:"line_format" yaml:"line_format"`
+
+	// AllowInlineLineBreaks keeps line breaks inside a single log record
+	AllowInlineLineBreaks bool `json:"allow_inline_line_breaks" yaml:"allow_inline_line_breaks"`
+
+	// IgnoreEmptyContextAndExtra omits empty %context% and %extra% instead of printing "[]"
+	IgnoreEmptyContextAndExtra bool `json:"ignore_empty_context_and_extra" yaml:"ignore_empty_context_and_extra"`
 }
 
 // SlackConfig contains configuration for the Slack driver
@@ -76,10 +136,45 @@ type SlackConfig struct {
 	// Timeout is the HTTP timeout for sending to Slack
 	Timeout time.Duration `json:"timeout" yaml:"timeout"`
 
-	// Async determines if messages should be sent asynchronously
+	// Async sends each message in its own goroutine; prefer AsyncConfig,
+	// which bounds the number of pending messages
 	Async bool `json:"async" yaml:"async"`
 }
 
+// SyslogConfig contains configuration for the syslog driver
+type SyslogConfig struct {
+	// Network is "udp", "tcp" or "unix"; empty uses the local syslog socket
+	// (rfc5424 driver: "tcp" by default, or "udp")
+	Network string `json:"network" yaml:"network"`
+
+	// Address is the syslog server address (e.g. "localhost:514"); empty uses /dev/log
+	Address string `json:"address" yaml:"address"`
+
+	// Facility is the syslog facility name, e.g. "user" (default) or "local0"
+	Facility string `json:"facility" yaml:"facility"`
+
+	// Ident is the tag prepended to messages (default: Config.AppName)
+	Ident string `json:"ident" yaml:"ident"`
+
+	// Hostname is the HOSTNAME sent by the rfc5424 driver (default: os.Hostname)
+	Hostname string `json:"hostname" yaml:"hostname"`
+
+	// StructuredDataID is the SD-ID used for context by the rfc5424 driver (default: "golog@32473")
+	StructuredDataID string `json:"structured_data_id" yaml:"structured_data_id"`
+
+	// TLS enables TLS for the rfc5424 driver over tcp
+	TLS bool `json:"tls" yaml:"tls"`
+
+	// TLSConfig customizes the TLS connection (certificates, server name)
+	TLSConfig *tls.Config `json:"-" yaml:"-"`
+
+	// WriteTimeout bounds connecting and writing for the rfc5424 driver (default: 5s)
+	WriteTimeout time.Duration `json:"write_timeout" yaml:"write_timeout"`
+
+	// BufferSize is the number of messages the rfc5424 driver keeps while disconnected (default: 1000)
+	BufferSize int `json:"buffer_size" yaml:"buffer_size"`
+}
+
 // StackConfig contains configuration for the stack driver (multiple channels)
 type StackConfig struct {
 	// Channels is a list of channel names to log to
@@ -89,6 +184,36 @@ type StackConfig struct {
 	IgnoreExceptions bool `json:"ignore_exceptions" yaml:"ignore_exceptions"`
 }
 
+// FallbackConfig contains configuration for the fallback driver
+type FallbackConfig struct {
+	// FailureThreshold is the number of consecutive failures after which a
+	// channel is skipped for Cooldown (0 = never skip)
+	FailureThreshold int `json:"failure_threshold" yaml:"failure_threshold"`
+
+	// Cooldown is how long a failing channel is skipped (default: 30s)
+	Cooldown time.Duration `json:"cooldown" yaml:"cooldown"`
+}
+
+// AsyncConfig contains configuration for writing a channel asynchronously
+type AsyncConfig struct {
+	// QueueSize is the number of entries that may wait to be written (0 = synchronous)
+	QueueSize int `json:"queue_size" yaml:"queue_size"`
+
+	// Workers is the number of goroutines writing entries (default: 1).
+	// Entries may be written out of order with more than one worker.
+	Workers int `json:"workers" yaml:"workers"`
+
+	// Overflow is what happens to an entry when the queue is full (default: "block")
+	Overflow OverflowPolicy `json:"overflow" yaml:"overflow"`
+
+	// OverflowLevel is the level below which the "drop_below_level" policy
+	// drops entries when the queue is full (default: warning)
+	OverflowLevel string `json:"overflow_level" yaml:"overflow_level"`
+
+	// DrainTimeout is how long Close waits for queued entries to be written (default: 5s)
+	DrainTimeout time.Duration `json:"drain_timeout" yaml:"drain_timeout"`
+}
+
 // DefaultConfig returns a sensible default configuration
 func DefaultConfig() *Config {
 	return &Config{
@@ -162,6 +287,59 @@ func WithSlackAsync(async bool) SlackOption {
 	}
 }
 
+// NewSyslogChannelConfig creates a syslog channel configuration for the given
+// network and address; empty values use the local syslog socket
+func NewSyslogChannelConfig(network, address string, options ...SyslogOption) ChannelConfig {
+	cfg := ChannelConfig{
+		Driver: "syslog",
+		Level:  "debug",
+		SyslogConfig: &SyslogConfig{
+			Network:  network,
+			Address:  address,
+			Facility: "user",
+		},
+	}
+
+	for _, opt := range options {
+		opt(cfg.SyslogConfig)
+	}
+
+	return cfg
+}
+
+// SyslogOption is a function that configures a SyslogConfig
+type SyslogOption func(*SyslogConfig)
+
+// WithSyslogFacility sets the syslog facility
+func WithSyslogFacility(facility string) SyslogOption {
+	return func(c *SyslogConfig) {
+		c.Facility = facility
+	}
+}
+
+// WithSyslogIdent sets the syslog ident
+func WithSyslogIdent(ident string) SyslogOption {
+	return func(c *SyslogConfig) {
+		c.Ident = ident
+	}
+}
+
+// NewStderrChannelConfig creates a channel configuration that logs to stderr
+func NewStderrChannelConfig() ChannelConfig {
+	return ChannelConfig{
+		Driver: "stderr",
+		Level:  "debug",
+	}
+}
+
+// NewStdoutChannelConfig creates a channel configuration that logs to stdout
+func NewStdoutChannelConfig() ChannelConfig {
+	return ChannelConfig{
+		Driver: "stdout",
+		Level:  "debug",
+	}
+}
+
 // NewFileChannelConfig creates a new file channel configuration
 func NewFileChannelConfig(path string, options ...FileOption) ChannelConfig {
 	cfg := ChannelConfig{
@@ -184,6 +362,26 @@ func NewFileChannelConfig(path string, options ...FileOption) ChannelConfig {
 	return cfg
 }
 
+// NewDailyChannelConfig creates a new daily file channel configuration
+// that keeps the given number of days
+func NewDailyChannelConfig(path string, days int, options ...FileOption) ChannelConfig {
+	cfg := ChannelConfig{
+		Driver: "daily",
+		Level:  "debug",
+		FileConfig: &FileConfig{
+			Path:       path,
+			Days:       days,
+			DateFormat: "2006-01-02 15:04:05",
+		},
+	}
+
+	for _, opt := range options {
+		opt(cfg.FileConfig)
+	}
+
+	return cfg
+}
+
 // FileOption is a function that configures a FileConfig
 type FileOption func(*FileConfig)
 
@@ -194,9 +392,65 @@ func WithFileMaxSize(size int) FileOption {
 	}
 }
 
+// WithFileMaxBackups sets the number of rotated files to keep
+func WithFileMaxBackups(n int) FileOption {
+	return func(c *FileConfig) {
+		c.MaxBackups = n
+	}
+}
+
+// WithFileMaxAge sets the number of days to keep rotated files
+func WithFileMaxAge(days int) FileOption {
+	return func(c *FileConfig) {
+		c.MaxAge = days
+	}
+}
+
+// WithFileCompress enables gzip compression of rotated files
+func WithFileCompress(compress bool) FileOption {
+	return func(c *FileConfig) {
+		c.Compress = compress
+	}
+}
+
+// WithFileTimezone sets the time zone used by the daily driver
+func WithFileTimezone(tz string) FileOption {
+	return func(c *FileConfig) {
+		c.Timezone = tz
+	}
+}
+
 // WithFileDateFormat sets the date format
 func WithFileDateFormat(format string) FileOption {
 	return func(c *FileConfig) {
 		c.DateFormat = format
 	}
 }
+
+// NewFallbackChannelConfig creates a fallback channel configuration that
+// writes each entry to the first of the channels that succeeds
+func NewFallbackChannelConfig(channels []string, options ...FallbackOption) ChannelConfig {
+	cfg := ChannelConfig{
+		Driver:         "fallback",
+		Level:          "debug",
+		StackConfig:    &StackConfig{Channels: channels},
+		FallbackConfig: &FallbackConfig{},
+	}
+
+	for _, opt := range options {
+		opt(&cfg)
+	}
+
+	return cfg
+}
+
+// FallbackOption is a function that configures a fallback channel
+type FallbackOption func(*ChannelConfig)
+
+// WithFallbackHealth skips a channel for cooldown after threshold consecutive failures
+func WithFallbackHealth(threshold int, cooldown time.Duration) FallbackOption {
+	return func(c *ChannelConfig) {
+		c.FallbackConfig.FailureThreshold = threshold
+		c.FallbackConfig.Cooldown = cooldown
+	}
+}
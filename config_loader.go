@@ -0,0 +1,163 @@
+package golog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// envPlaceholder matches ${VAR} and ${VAR:-default}
+var envPlaceholder = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)(?::-([^}]*))?\}`)
+
+// durationFields are channel options given as Go durations ("10s") in config files
+var durationFields = []string{"timeout", "write_timeout", "cooldown", "drain_timeout"}
+
+// LoadConfig reads a JSON configuration file, see LoadConfigFrom
+func LoadConfig(path string) (*Config, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open log config: %w", err)
+	}
+	defer f.Close()
+
+	config, err := LoadConfigFrom(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return config, nil
+}
+
+// LoadConfigFrom reads a JSON configuration from r.
+//
+// ${VAR} and ${VAR:-default} are replaced with environment variables before
+// parsing; the default applies when VAR is unset or empty, and an unset VAR
+// without a default is an error. Durations such as "timeout" accept strings
+// like "10s". The result is checked with Config.Validate.
+func LoadConfigFrom(r io.Reader) (*Config, error) {
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read log config: %w", err)
+	}
+
+	expanded, err := expandEnv(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	normalized, err := normalizeDurations(expanded)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(normalized))
+	dec.DisallowUnknownFields()
+
+	var config Config
+	if err := dec.Decode(&config); err != nil {
+		return nil, fmt.Errorf("failed to parse log config: %w", err)
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// expandEnv replaces environment placeholders in JSON text. Inside strings the
+// value is JSON-escaped; elsewhere it is inserted as is, so numbers and booleans
+// can come from the environment too.
+func expandEnv(data []byte) ([]byte, error) {
+	var missing []string
+	var out bytes.Buffer
+
+	inString, escaped := false, false
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+
+		if c == '$' {
+			if loc := envPlaceholder.FindSubmatchIndex(data[i:]); loc != nil && loc[0] == 0 {
+				name := string(data[i+loc[2] : i+loc[3]])
+				value, ok := os.LookupEnv(name)
+				if value == "" && loc[4] >= 0 {
+					value, ok = string(data[i+loc[4]:i+loc[5]]), true
+				}
+				if !ok {
+					missing = append(missing, name)
+				}
+
+				if inString {
+					quoted, _ := json.Marshal(value)
+					out.Write(quoted[1 : len(quoted)-1])
+				} else {
+					out.WriteString(value)
+				}
+				i += loc[1] - 1
+				escaped = false
+				continue
+			}
+		}
+
+		switch {
+		case escaped:
+			escaped = false
+		case inString && c == '\\':
+			escaped = true
+		case c == '"':
+			inString = !inString
+		}
+		out.WriteByte(c)
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("environment variable [%s] is not set", strings.Join(missing, ", "))
+	}
+	return out.Bytes(), nil
+}
+
+// normalizeDurations converts duration strings in channel configs to nanoseconds
+func normalizeDurations(data []byte) ([]byte, error) {
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse log config: %w", err)
+	}
+
+	rawChannels, ok := doc["channels"]
+	if !ok {
+		return data, nil
+	}
+
+	var channels map[string]map[string]json.RawMessage
+	err := json.Unmarshal(rawChannels, &channels)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse log config: channels: %w", err)
+	}
+
+	for name, fields := range channels {
+		for _, key := range durationFields {
+			value, ok := fields[key]
+			if !ok || len(value) == 0 || value[0] != '"' {
+				continue
+			}
+
+			var s string
+			if err := json.Unmarshal(value, &s); err != nil {
+				return nil, fmt.Errorf("channel [%s] %s: %w", name, key, err)
+			}
+			d, err := time.ParseDuration(s)
+			if err != nil {
+				return nil, fmt.Errorf("channel [%s] %s: invalid duration %q", name, key, s)
+			}
+			fields[key] = json.RawMessage(fmt.Sprintf("%d", int64(d)))
+		}
+	}
+
+	if doc["channels"], err = json.Marshal(channels); err != nil {
+		return nil, fmt.Errorf("failed to parse log config: %w", err)
+	}
+	return json.Marshal(doc)
+}
@@ -0,0 +1,170 @@
+package golog
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testConfigJSON = `{
+	"default": "stack",
+	"app_name": "${APP_NAME:-Shop}",
+	"channels": {
+		"stack": {"driver": "stack", "channels": ["file", "slack"]},
+		"file": {"driver": "file", "level": "debug", "path": "logs/app.log", "max_size": ${LOG_MAX_SIZE:-50}},
+		"slack": {
+			"driver": "slack",
+			"level": "error",
+			"webhook_url": "${GOLOG_TEST_WEBHOOK}",
+			"timeout": "${SLACK_TIMEOUT:-10s}"
+		}
+	}
+}`
+
+func TestLoadConfigFrom(t *testing.T) {
+	t.Setenv("GOLOG_TEST_WEBHOOK", `https://hooks.slack.com/services/T0/B0/"x"`)
+	t.Setenv("APP_NAME", "")
+
+	config, err := LoadConfigFrom(strings.NewReader(testConfigJSON))
+	if err != nil {
+		t.Fatalf("LoadConfigFrom failed: %v", err)
+	}
+
+	if config.Default != "stack" || config.AppName != "Shop" {
+		t.Errorf("Unexpected default %q / app name %q", config.Default, config.AppName)
+	}
+
+	file := config.Channels["file"]
+	if file.FileConfig == nil || file.FileConfig.Path != "logs/app.log" || file.FileConfig.MaxSize != 50 {
+		t.Errorf("Unexpected file config %+v", file.FileConfig)
+	}
+
+	slack := config.Channels["slack"]
+	if slack.SlackConfig.WebhookURL != `https://hooks.slack.com/services/T0/B0/"x"` {
+		t.Errorf("Unexpected webhook %q", slack.SlackConfig.WebhookURL)
+	}
+	if slack.SlackConfig.Timeout != 10*time.Second {
+		t.Errorf("Expected timeout 10s, got %v", slack.SlackConfig.Timeout)
+	}
+
+	if got := config.Channels["stack"].StackConfig.Channels; len(got) != 2 {
+		t.Errorf("Unexpected stack channels %v", got)
+	}
+}
+
+func TestLoadConfigFrom_Channels(t *testing.T) {
+	tests := []struct {
+		name    string
+		channel string
+		check   func(ChannelConfig) bool
+	}{
+		{
+			"fallback",
+			`{"driver": "fallback", "channels": ["file", "stderr"], "failure_threshold": 3, "cooldown": "1m"}`,
+			func(c ChannelConfig) bool {
+				return len(c.StackConfig.Channels) == 2 && c.StackConfig.Channels[0] == "file" &&
+					c.FallbackConfig.FailureThreshold == 3 && c.FallbackConfig.Cooldown == time.Minute
+			},
+		},
+		{
+			"async",
+			`{"driver": "stderr", "queue_size": 100, "workers": 2, "overflow": "drop_oldest", "drain_timeout": "2s"}`,
+			func(c ChannelConfig) bool {
+				ac := c.AsyncConfig
+				return ac.QueueSize == 100 && ac.Workers == 2 && ac.Overflow == OverflowDropOldest && ac.DrainTimeout == 2*time.Second
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := LoadConfigFrom(strings.NewReader(`{
+				"default": "app",
+				"channels": {
+					"app": ` + tt.channel + `,
+					"file": {"driver": "file", "path": "app.log"},
+					"stderr": {"driver": "stderr"}
+				}
+			}`))
+			if err != nil {
+				t.Fatalf("LoadConfigFrom failed: %v", err)
+			}
+			if app := config.Channels["app"]; !tt.check(app) {
+				t.Errorf("Unexpected channel config %+v", app)
+			}
+		})
+	}
+}
+
+func TestLoadConfig_File(t *testing.T) {
+	t.Setenv("GOLOG_TEST_WEBHOOK", "https://hooks.slack.com/test")
+
+	path := filepath.Join(t.TempDir(), "logging.json")
+	if err := os.WriteFile(path, []byte(testConfigJSON), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+	if config.Channels["slack"].SlackConfig.WebhookURL != "https://hooks.slack.com/test" {
+		t.Error("Expected webhook from the environment")
+	}
+
+	if _, err := LoadConfig(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("Expected error for missing file")
+	}
+}
+
+func TestLoadConfigFrom_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want string
+	}{
+		{"missing env", `{"default": "${GOLOG_TEST_UNSET}"}`, "environment variable [GOLOG_TEST_UNSET] is not set"},
+		{"invalid json", `{"default": }`, "failed to parse log config"},
+		{"unknown field", `{"channels": {"a": {"driver": "file", "pathh": "x"}}}`, `unknown field "pathh"`},
+		{"bad duration", `{"channels": {"s": {"driver": "slack", "timeout": "ten"}}}`, `channel [s] timeout: invalid duration "ten"`},
+		{"unknown driver", `{"channels": {"a": {"driver": "kafka"}}}`, "channel [a]: driver [kafka] is not supported"},
+		{"missing default", `{"default": "nope", "channels": {}}`, "default channel [nope] is not defined"},
+		{"missing stack member", `{"channels": {"s": {"driver": "stack", "channels": ["ghost"]}}}`, "channel [s]: channel [ghost] in stack is not defined"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadConfigFrom(strings.NewReader(tt.json))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestExpandEnv(t *testing.T) {
+	t.Setenv("GOLOG_TEST_VALUE", "a\\b")
+
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{`"${GOLOG_TEST_VALUE}"`, `"a\\b"`},
+		{`"${GOLOG_TEST_UNSET:-fallback}"`, `"fallback"`},
+		{`"${GOLOG_TEST_UNSET:-}"`, `""`},
+		{`{"n": ${GOLOG_TEST_UNSET:-3}}`, `{"n": 3}`},
+		{`"cost: $5"`, `"cost: $5"`},
+	}
+
+	for _, tt := range tests {
+		got, err := expandEnv([]byte(tt.in))
+		if err != nil {
+			t.Fatalf("expandEnv(%s) failed: %v", tt.in, err)
+		}
+		if string(got) != tt.want {
+			t.Errorf("expandEnv(%s) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
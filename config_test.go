@@ -144,6 +144,9 @@ func TestNewFileChannelConfig_WithOptions(t *testing.T) {
 	config := NewFileChannelConfig(
 		path,
 		WithFileMaxSize(50),
+		WithFileMaxBackups(7),
+		WithFileMaxAge(14),
+		WithFileCompress(false),
 		WithFileDateFormat("2006-01-02"),
 	)
 
@@ -151,6 +154,18 @@ func TestNewFileChannelConfig_WithOptions(t *testing.T) {
 		t.Errorf("Expected max size 50, got %d", config.FileConfig.MaxSize)
 	}
 
+	if config.FileConfig.MaxBackups != 7 {
+		t.Errorf("Expected max backups 7, got %d", config.FileConfig.MaxBackups)
+	}
+
+	if config.FileConfig.MaxAge != 14 {
+		t.Errorf("Expected max age 14, got %d", config.FileConfig.MaxAge)
+	}
+
+	if config.FileConfig.Compress {
+		t.Error("Expected compress to be disabled")
+	}
+
 	if config.FileConfig.DateFormat != "2006-01-02" {
 		t.Errorf("Expected date format '2006-01-02', got %q", config.FileConfig.DateFormat)
 	}
@@ -183,3 +198,59 @@ func TestChannelConfig_StackConfig(t *testing.T) {
 	}
 }
 
+func TestNewDailyChannelConfig(t *testing.T) {
+	config := NewDailyChannelConfig("logs/laravel.log", 14, WithFileTimezone("UTC"))
+
+	if config.Driver != "daily" {
+		t.Errorf("Expected driver 'daily', got %q", config.Driver)
+	}
+
+	if config.FileConfig == nil {
+		t.Fatal("Expected FileConfig to be set")
+	}
+
+	if config.FileConfig.Days != 14 {
+		t.Errorf("Expected 14 days, got %d", config.FileConfig.Days)
+	}
+
+	if config.FileConfig.Timezone != "UTC" {
+		t.Errorf("Expected timezone 'UTC', got %q", config.FileConfig.Timezone)
+	}
+}
+
+func TestNewConsoleChannelConfigs(t *testing.T) {
+	if cfg := NewStdoutChannelConfig(); cfg.Driver != "stdout" || cfg.Level != "debug" {
+		t.Errorf("Unexpected stdout config %+v", cfg)
+	}
+
+	if cfg := NewStderrChannelConfig(); cfg.Driver != "stderr" || cfg.Level != "debug" {
+		t.Errorf("Unexpected stderr config %+v", cfg)
+	}
+}
+
+func TestNewSyslogChannelConfig(t *testing.T) {
+	config := NewSyslogChannelConfig("udp", "localhost:514",
+		WithSyslogFacility("local3"),
+		WithSyslogIdent("myapp"),
+	)
+
+	if config.Driver != "syslog" {
+		t.Errorf("Expected driver 'syslog', got %q", config.Driver)
+	}
+
+	if config.SyslogConfig == nil {
+		t.Fatal("Expected SyslogConfig to be set")
+	}
+
+	if config.SyslogConfig.Network != "udp" || config.SyslogConfig.Address != "localhost:514" {
+		t.Errorf("Unexpected address %s/%s", config.SyslogConfig.Network, config.SyslogConfig.Address)
+	}
+
+	if config.SyslogConfig.Facility != "local3" {
+		t.Errorf("Expected facility 'local3', got %q", config.SyslogConfig.Facility)
+	}
+
+	if config.SyslogConfig.Ident != "myapp" {
+		t.Errorf("Expected ident 'myapp', got %q", config.SyslogConfig.Ident)
+	}
+}
@@ -0,0 +1,228 @@
+package golog
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ValidationError lists every problem found by Config.Validate
+type ValidationError struct {
+	Errors []error
+}
+
+// Error returns all problems, one per line
+func (e *ValidationError) Error() string {
+	var b strings.Builder
+	b.WriteString("invalid log configuration:")
+	for _, err := range e.Errors {
+		b.WriteString("\n  - ")
+		b.WriteString(err.Error())
+	}
+	return b.String()
+}
+
+// Unwrap returns the individual problems for errors.Is and errors.As
+func (e *ValidationError) Unwrap() []error {
+	return e.Errors
+}
+
+// Validate checks the configuration before any channel is created: the
+// default channel exists, drivers, formatters and processors are registered,
+// levels parse, required driver options are present, and stack channels
+// reference defined channels without cycles. All problems are returned at
+// once as a *ValidationError.
+func (c *Config) Validate() error {
+	var errs []error
+	addf := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	if c.Default == "" {
+		addf("default channel is not set")
+	} else if _, exists := c.Channels[c.Default]; !exists {
+		addf("default channel [%s] is not defined", c.Default)
+	}
+
+	if c.FallbackChannel != "" {
+		if _, exists := c.Channels[c.FallbackChannel]; !exists {
+			addf("fallback channel [%s] is not defined", c.FallbackChannel)
+		}
+	}
+
+	for _, name := range c.channelNames() {
+		for _, err := range c.validateChannel(name, c.Channels[name]) {
+			errs = append(errs, fmt.Errorf("channel [%s]: %w", name, err))
+		}
+	}
+
+	for _, cycle := range c.stackCycles() {
+		addf("stack cycle %s", strings.Join(cycle, " -> "))
+	}
+
+	if len(errs) > 0 {
+		return &ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// channelNames returns the configured channel names in sorted order
+func (c *Config) channelNames() []string {
+	names := make([]string, 0, len(c.Channels))
+	for name := range c.Channels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// validateChannel returns the problems of a single channel
+func (c *Config) validateChannel(name string, config ChannelConfig) []error {
+	var errs []error
+	addf := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	if config.Level != "" {
+		if _, err := parseLevel(config.Level); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if config.CallerLevel != "" {
+		if _, err := parseLevel(config.CallerLevel); err != nil {
+			addf("caller_level: %w", err)
+		}
+	}
+	if config.Formatter != "" {
+		if _, exists := GetFormatterFactory(config.Formatter); !exists {
+			addf("formatter [%s] is not supported", config.Formatter)
+		}
+	}
+	for _, p := range config.Processors {
+		if _, exists := GetProcessor(p); !exists {
+			addf("processor [%s] is not registered", p)
+		}
+	}
+	if ac := config.AsyncConfig; ac != nil {
+		if ac.QueueSize < 0 || ac.Workers < 0 || ac.DrainTimeout < 0 {
+			addf("queue_size, workers and drain_timeout must not be negative")
+		}
+		if !ac.Overflow.valid() {
+			addf("overflow policy [%s] is not supported", ac.Overflow)
+		}
+		if ac.OverflowLevel != "" {
+			if _, err := parseLevel(ac.OverflowLevel); err != nil {
+				addf("overflow_level: %w", err)
+			}
+		}
+	}
+
+	switch config.Driver {
+	case "":
+		addf("driver is required")
+		return errs
+	case "stack", "fallback":
+		if config.StackConfig == nil || len(config.StackConfig.Channels) == 0 {
+			addf("%s requires channel list", config.Driver)
+			return errs
+		}
+		for _, member := range config.StackConfig.Channels {
+			if _, exists := c.Channels[member]; !exists {
+				addf("channel [%s] in %s is not defined", member, config.Driver)
+			}
+		}
+		if fc := config.FallbackConfig; fc != nil && (fc.FailureThreshold < 0 || fc.Cooldown < 0) {
+			addf("failure_threshold and cooldown must not be negative")
+		}
+		return errs
+	}
+
+	if _, exists := GetDriverFactory(config.Driver); !exists {
+		addf("driver [%s] is not supported", config.Driver)
+		return errs
+	}
+
+	switch config.Driver {
+	case "file", "daily":
+		if config.FileConfig == nil {
+			addf("file configuration (path) is required")
+		} else if tz := config.FileConfig.Timezone; tz != "" {
+			if _, err := time.LoadLocation(tz); err != nil {
+				addf("invalid timezone [%s]: %w", tz, err)
+			}
+		}
+	case "slack":
+		if config.SlackConfig == nil || config.SlackConfig.WebhookURL == "" {
+			addf("slack webhook URL is required")
+		}
+	case "syslog", "rfc5424":
+		sc := config.SyslogConfig
+		if sc == nil {
+			sc = &SyslogConfig{}
+		}
+		if _, err := parseSyslogFacility(sc.Facility); err != nil {
+			errs = append(errs, err)
+		}
+		if config.Driver == "rfc5424" {
+			if sc.Address == "" {
+				addf("syslog address is required")
+			}
+			if sc.Network != "" && sc.Network != "tcp" && sc.Network != "udp" {
+				addf("syslog network [%s] is not supported", sc.Network)
+			}
+			if sc.TLS && sc.Network == "udp" {
+				addf("syslog TLS requires the tcp network")
+			}
+		}
+	}
+
+	return errs
+}
+
+// stackCycles returns every cycle of stack or fallback channels referencing each other,
+// each as the path of channel names ending where it started
+func (c *Config) stackCycles() [][]string {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[string]int)
+	var cycles [][]string
+	var path []string
+
+	var visit func(name string)
+	visit = func(name string) {
+		config, exists := c.Channels[name]
+		if !exists || len(memberChannels(config)) == 0 {
+			return
+		}
+		switch state[name] {
+		case visiting:
+			for i, n := range path {
+				if n == name {
+					cycle := append(append([]string{}, path[i:]...), name)
+					cycles = append(cycles, cycle)
+					break
+				}
+			}
+			return
+		case done:
+			return
+		}
+
+		state[name] = visiting
+		path = append(path, name)
+		for _, member := range memberChannels(config) {
+			visit(member)
+		}
+		path = path[:len(path)-1]
+		state[name] = done
+	}
+
+	for _, name := range c.channelNames() {
+		visit(name)
+	}
+	return cycles
+}
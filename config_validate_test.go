@@ -0,0 +1,140 @@
+package golog
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConfig_Validate_Default(t *testing.T) {
+	if err := DefaultConfig().Validate(); err != nil {
+		t.Errorf("Expected default config to be valid, got %v", err)
+	}
+}
+
+func TestConfig_Validate_AggregatesErrors(t *testing.T) {
+	config := &Config{
+		Default: "missing",
+		Channels: map[string]ChannelConfig{
+			"async":      {Driver: "stdout", AsyncConfig: &AsyncConfig{QueueSize: -1, Overflow: "spill", OverflowLevel: "loud"}},
+			"file":       {Driver: "file", Level: "verbose", FileConfig: &FileConfig{}},
+			"nofile":     {Driver: "daily"},
+			"slack":      {Driver: "slack", SlackConfig: &SlackConfig{}},
+			"syslog":     {Driver: "rfc5424", SyslogConfig: &SyslogConfig{Network: "udp", TLS: true, Facility: "kern2"}},
+			"kafka":      {Driver: "kafka"},
+			"empty":      {},
+			"fmt":        {Driver: "stdout", Formatter: "xml", CallerLevel: "loud", Processors: []string{"nope"}},
+			"stack":      {Driver: "stack", StackConfig: &StackConfig{Channels: []string{"file", "ghost"}}},
+			"tz":         {Driver: "daily", FileConfig: &FileConfig{Timezone: "Mars/Olympus"}},
+			"nostack":    {Driver: "stack"},
+			"fallback":   NewFallbackChannelConfig([]string{"file", "ghost"}),
+			"nofallback": {Driver: "fallback"},
+			"health":     NewFallbackChannelConfig([]string{"file"}, WithFallbackHealth(-1, -time.Second)),
+		},
+	}
+
+	err := config.Validate()
+
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("Expected *ValidationError, got %v", err)
+	}
+
+	want := []string{
+		"default channel [missing] is not defined",
+		"channel [async]: queue_size, workers and drain_timeout must not be negative",
+		"channel [async]: overflow policy [spill] is not supported",
+		"channel [async]: overflow_level: level [loud] is not valid",
+		"channel [empty]: driver is required",
+		"channel [fallback]: channel [ghost] in fallback is not defined",
+		"channel [file]: level [verbose] is not valid",
+		"channel [fmt]: caller_level: level [loud] is not valid",
+		"channel [fmt]: formatter [xml] is not supported",
+		"channel [fmt]: processor [nope] is not registered",
+		"channel [health]: failure_threshold and cooldown must not be negative",
+		"channel [kafka]: driver [kafka] is not supported",
+		"channel [nofallback]: fallback requires channel list",
+		"channel [nofile]: file configuration (path) is required",
+		"channel [nostack]: stack requires channel list",
+		"channel [slack]: slack webhook URL is required",
+		"channel [stack]: channel [ghost] in stack is not defined",
+		"channel [syslog]: syslog facility [kern2] is not supported",
+		"channel [syslog]: syslog address is required",
+		"channel [syslog]: syslog TLS requires the tcp network",
+		"channel [tz]: invalid timezone [Mars/Olympus]",
+	}
+	msg := err.Error()
+	for _, w := range want {
+		if !strings.Contains(msg, w) {
+			t.Errorf("Expected %q in:\n%s", w, msg)
+		}
+	}
+	if len(verr.Errors) != len(want) {
+		t.Errorf("Expected %d problems, got %d:\n%s", len(want), len(verr.Errors), msg)
+	}
+}
+
+func TestConfig_Validate_StackCycle(t *testing.T) {
+	config := &Config{
+		Default: "a",
+		Channels: map[string]ChannelConfig{
+			"a":    {Driver: "stack", StackConfig: &StackConfig{Channels: []string{"b", "out"}}},
+			"b":    {Driver: "stack", StackConfig: &StackConfig{Channels: []string{"a"}}},
+			"self": {Driver: "stack", StackConfig: &StackConfig{Channels: []string{"self"}}},
+			"fa":   NewFallbackChannelConfig([]string{"fb", "out"}),
+			"fb":   NewFallbackChannelConfig([]string{"fa"}),
+			"out":  NewStdoutChannelConfig(),
+		},
+	}
+
+	err := config.Validate()
+	if err == nil {
+		t.Fatal("Expected cycle error")
+	}
+	for _, w := range []string{"stack cycle a -> b -> a", "stack cycle self -> self", "stack cycle fa -> fb -> fa"} {
+		if !strings.Contains(err.Error(), w) {
+			t.Errorf("Expected %q in:\n%s", w, err)
+		}
+	}
+}
+
+func TestManager_NestedStack(t *testing.T) {
+	tempDir := t.TempDir()
+	logPath := filepath.Join(tempDir, "app.log")
+
+	manager, err := NewManager(&Config{
+		Default: "outer",
+		Channels: map[string]ChannelConfig{
+			"outer": {Driver: "stack", StackConfig: &StackConfig{Channels: []string{"inner"}}},
+			"inner": {Driver: "stack", StackConfig: &StackConfig{Channels: []string{"file"}}},
+			"file":  NewFileChannelConfig(logPath),
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewManager failed: %v", err)
+	}
+	defer manager.Close()
+
+	logger, err := manager.Default()
+	if err != nil {
+		t.Fatalf("Default failed: %v", err)
+	}
+	logger.Info("nested")
+
+	content, _ := os.ReadFile(logPath)
+	if !strings.Contains(string(content), "nested") {
+		t.Errorf("Expected entry in nested stack file, got %q", content)
+	}
+}
+
+func TestConfig_Validate_FallbackChannel(t *testing.T) {
+	config := DefaultConfig()
+	config.FallbackChannel = "backup"
+
+	if err := config.Validate(); err == nil || !strings.Contains(err.Error(), "fallback channel [backup] is not defined") {
+		t.Errorf("Expected undefined fallback channel error, got %v", err)
+	}
+}
@@ -0,0 +1,109 @@
+package golog
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// colorReset is the ANSI code that ends a Level.Color sequence
+const colorReset = "\033[0m"
+
+// ConsoleDriver writes log entries to stdout or stderr,
+// coloring them by level when the output is a terminal
+type ConsoleDriver struct {
+	mu        sync.Mutex
+	out       io.Writer
+	name      string
+	formatter Formatter
+	color     bool
+}
+
+// NewStdoutDriver creates a console driver that writes to stdout
+func NewStdoutDriver(config ChannelConfig) (Driver, error) {
+	return newConsoleDriver("stdout", os.Stdout, config)
+}
+
+// NewStderrDriver creates a console driver that writes to stderr (Laravel's "stderr" channel)
+func NewStderrDriver(config ChannelConfig) (Driver, error) {
+	return newConsoleDriver("stderr", os.Stderr, config)
+}
+
+// newConsoleDriver creates a console driver writing to file
+func newConsoleDriver(name string, file *os.File, config ChannelConfig) (Driver, error) {
+	formatter, err := NewFormatter(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConsoleDriver{
+		out:       file,
+		name:      name,
+		formatter: formatter,
+		color:     useColor(file),
+	}, nil
+}
+
+// useColor reports whether ANSI colors should be written to file:
+// only for terminals, and never when NO_COLOR is set (https://no-color.org)
+func useColor(file *os.File) bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	return isTerminal(file)
+}
+
+// isTerminal reports whether file is a character device such as a TTY
+func isTerminal(file *os.File) bool {
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// Log writes a log entry to the console
+func (d *ConsoleDriver) Log(entry *Entry) error {
+	formatted, err := d.formatter.Format(entry)
+	if err != nil {
+		return fmt.Errorf("failed to format log entry: %w", err)
+	}
+
+	if d.color {
+		formatted = colorize(formatted, entry.Level)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	_, err = d.out.Write(formatted)
+	return err
+}
+
+// colorize wraps the first line of a formatted entry in the level's color
+func colorize(formatted []byte, level Level) []byte {
+	header, rest, found := bytes.Cut(formatted, []byte("\n"))
+
+	var buf bytes.Buffer
+	buf.Grow(len(formatted) + len(level.Color()) + len(colorReset))
+	buf.WriteString(level.Color())
+	buf.Write(header)
+	buf.WriteString(colorReset)
+	if found {
+		buf.WriteByte('\n')
+		buf.Write(rest)
+	}
+	return buf.Bytes()
+}
+
+// Close is a no-op; the standard streams are left open
+func (d *ConsoleDriver) Close() error {
+	return nil
+}
+
+// Name returns the driver name
+func (d *ConsoleDriver) Name() string {
+	return d.name
+}
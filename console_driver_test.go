@@ -0,0 +1,99 @@
+package golog
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNewConsoleDrivers(t *testing.T) {
+	tests := []struct {
+		name    string
+		factory DriverFactory
+	}{
+		{"stdout", NewStdoutDriver},
+		{"stderr", NewStderrDriver},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			driver, err := tt.factory(ChannelConfig{Driver: tt.name})
+			if err != nil {
+				t.Fatalf("factory failed: %v", err)
+			}
+			if driver.Name() != tt.name {
+				t.Errorf("Expected driver name %q, got %q", tt.name, driver.Name())
+			}
+			if err := driver.Close(); err != nil {
+				t.Errorf("Close failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestConsoleDriver_Plain(t *testing.T) {
+	var buf bytes.Buffer
+	driver := &ConsoleDriver{out: &buf, name: "stdout", formatter: NewLineFormatter("")}
+
+	entry := NewEntry(ErrorLevel, "plain message")
+	entry.SetChannel("app")
+	if err := driver.Log(entry); err != nil {
+		t.Fatalf("Log failed: %v", err)
+	}
+
+	if strings.Contains(buf.String(), "\033[") {
+		t.Errorf("Expected no ANSI codes, got %q", buf.String())
+	}
+	if !strings.Contains(buf.String(), "app.ERROR: plain message") {
+		t.Errorf("Unexpected output %q", buf.String())
+	}
+}
+
+func TestConsoleDriver_Color(t *testing.T) {
+	var buf bytes.Buffer
+	driver := &ConsoleDriver{out: &buf, name: "stderr", formatter: NewLineFormatter(""), color: true}
+
+	entry := NewEntry(WarningLevel, "colored message")
+	entry.With("key", "value")
+	if err := driver.Log(entry); err != nil {
+		t.Fatalf("Log failed: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.HasPrefix(out, WarningLevel.Color()) {
+		t.Errorf("Expected output to start with warning color, got %q", out)
+	}
+	if !strings.Contains(out, "colored message"+colorReset+"\n  key: value") {
+		t.Errorf("Expected only the header line to be colored, got %q", out)
+	}
+}
+
+func TestUseColor(t *testing.T) {
+	file, err := os.Create(filepath.Join(t.TempDir(), "out.log"))
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	defer file.Close()
+
+	if isTerminal(file) {
+		t.Error("Regular file should not be a terminal")
+	}
+	if useColor(file) {
+		t.Error("Expected no color for a regular file")
+	}
+
+	t.Setenv("NO_COLOR", "1")
+	if useColor(os.Stdout) {
+		t.Error("Expected NO_COLOR to disable colors")
+	}
+}
+
+func TestColorize_SingleLine(t *testing.T) {
+	out := colorize([]byte("line"), InfoLevel)
+	want := InfoLevel.Color() + "line" + colorReset
+	if string(out) != want {
+		t.Errorf("colorize() = %q, want %q", out, want)
+	}
+}
@@ -0,0 +1,45 @@
+package golog
+
+import "context"
+
+// contextKey is the type of keys golog stores in a context.Context
+type contextKey int
+
+const (
+	loggerKey contextKey = iota
+	fieldsKey
+)
+
+// NewContext returns a copy of ctx carrying the logger, retrievable with FromContext
+func NewContext(ctx context.Context, logger *Logger) context.Context {
+	return context.WithValue(ctx, loggerKey, logger)
+}
+
+// FromContext returns the logger stored in ctx by NewContext
+func FromContext(ctx context.Context) (*Logger, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+	logger, ok := ctx.Value(loggerKey).(*Logger)
+	return logger, ok && logger != nil
+}
+
+// WithFields returns a copy of ctx carrying fields (e.g. request or trace IDs)
+// that are added to every entry logged with a *Context method.
+// Fields already in ctx are kept unless overridden.
+func WithFields(ctx context.Context, fields map[string]any) context.Context {
+	merged := mergeContext(FieldsFromContext(ctx), fields)
+	return context.WithValue(ctx, fieldsKey, merged)
+}
+
+// FieldsFromContext returns a copy of the fields stored in ctx by WithFields
+func FieldsFromContext(ctx context.Context) map[string]any {
+	if ctx == nil {
+		return nil
+	}
+	fields, _ := ctx.Value(fieldsKey).(map[string]any)
+	if fields == nil {
+		return nil
+	}
+	return mergeContext(fields)
+}
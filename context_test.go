@@ -0,0 +1,116 @@
+package golog
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNewContext_FromContext(t *testing.T) {
+	logger, _ := createTestLogger(t)
+
+	ctx := NewContext(context.Background(), logger)
+	got, ok := FromContext(ctx)
+	if !ok || got != logger {
+		t.Error("Expected logger to be retrieved from context")
+	}
+
+	if _, ok := FromContext(context.Background()); ok {
+		t.Error("Expected no logger in empty context")
+	}
+}
+
+func TestWithFields(t *testing.T) {
+	ctx := WithFields(context.Background(), map[string]any{"request_id": "abc", "user_id": 1})
+	ctx = WithFields(ctx, map[string]any{"user_id": 2, "trace_id": "t-1"})
+
+	fields := FieldsFromContext(ctx)
+	if fields["request_id"] != "abc" || fields["user_id"] != 2 || fields["trace_id"] != "t-1" {
+		t.Errorf("Unexpected fields %v", fields)
+	}
+
+	// Returned map is a copy
+	fields["request_id"] = "changed"
+	if FieldsFromContext(ctx)["request_id"] != "abc" {
+		t.Error("Expected FieldsFromContext to return a copy")
+	}
+
+	if FieldsFromContext(context.Background()) != nil {
+		t.Error("Expected nil fields for empty context")
+	}
+}
+
+func TestLogger_ContextMethods(t *testing.T) {
+	logger, logPath := createTestLogger(t)
+
+	ctx := WithFields(context.Background(), map[string]any{"request_id": "req-42", "source": "ctx"})
+
+	logger.DebugContext(ctx, "debug message")
+	logger.InfoContext(ctx, "info message")
+	logger.NoticeContext(ctx, "notice message")
+	logger.WarningContext(ctx, "warning message")
+	logger.ErrorContext(ctx, "error message")
+	logger.CriticalContext(ctx, "critical message")
+	logger.AlertContext(ctx, "alert message")
+	logger.EmergencyContext(ctx, "emergency message", map[string]any{"source": "call"})
+	logger.LogContext(ctx, InfoLevel, "log message")
+
+	content, _ := os.ReadFile(logPath)
+	logContent := string(content)
+
+	for _, level := range []string{"DEBUG", "INFO", "NOTICE", "WARNING", "ERROR", "CRITICAL", "ALERT", "EMERGENCY"} {
+		if !strings.Contains(logContent, level) {
+			t.Errorf("Expected %s in log", level)
+		}
+	}
+
+	if strings.Count(logContent, "request_id: req-42") != 9 {
+		t.Errorf("Expected request_id on every entry, got:\n%s", logContent)
+	}
+
+	if !strings.Contains(logContent, "source: call") {
+		t.Error("Expected call-site fields to override context fields")
+	}
+}
+
+func TestConvenienceLogging_Context(t *testing.T) {
+	resetGlobalState()
+	defer resetGlobalState()
+
+	logger, logPath := createTestLogger(t)
+	defaultPath := filepath.Join(t.TempDir(), "default.log")
+
+	Init(&Config{
+		Default: "file",
+		Channels: map[string]ChannelConfig{
+			"file": NewFileChannelConfig(defaultPath),
+		},
+	})
+	defer Close()
+
+	// Without a logger in the context the default channel is used
+	ctx := WithFields(context.Background(), map[string]any{"trace_id": "t-1"})
+	InfoContext(ctx, "to default")
+
+	content, _ := os.ReadFile(defaultPath)
+	if !strings.Contains(string(content), "to default") || !strings.Contains(string(content), "trace_id: t-1") {
+		t.Errorf("Expected entry in default channel, got %q", content)
+	}
+
+	// With a logger in the context it takes precedence
+	ctx = NewContext(ctx, logger)
+	DebugContext(ctx, "debug to ctx logger")
+	NoticeContext(ctx, "notice to ctx logger")
+	WarningContext(ctx, "warning to ctx logger")
+	ErrorContext(ctx, "error to ctx logger")
+	CriticalContext(ctx, "critical to ctx logger")
+	AlertContext(ctx, "alert to ctx logger")
+	EmergencyContext(ctx, "emergency to ctx logger")
+
+	content, _ = os.ReadFile(logPath)
+	if strings.Count(string(content), "to ctx logger") != 7 {
+		t.Errorf("Expected entries in context logger, got %q", content)
+	}
+}
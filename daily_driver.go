@@ -0,0 +1,218 @@
+package golog
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// dailyDateFormat is the date layout used in daily file names
+const dailyDateFormat = "2006-01-02"
+
+// DailyDriver writes log entries to a new date-suffixed file each day
+// (e.g. logs/app-2024-01-15.log), like Laravel's "daily" channel
+type DailyDriver struct {
+	mu       sync.Mutex
+	config   ChannelConfig
+	path     string
+	days     int
+	location *time.Location
+	date     string
+	current  *FileDriver
+	now      func() time.Time
+	remove   func(name string) error
+}
+
+// NewDailyDriver creates a new daily file driver from configuration
+func NewDailyDriver(config ChannelConfig) (Driver, error) {
+	if config.FileConfig == nil {
+		return nil, fmt.Errorf("file configuration is required")
+	}
+
+	path := config.FileConfig.Path
+	if path == "" {
+		path = "logs/app.log"
+	}
+
+	location := time.Local
+	if config.FileConfig.Timezone != "" {
+		loc, err := time.LoadLocation(config.FileConfig.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timezone [%s]: %w", config.FileConfig.Timezone, err)
+		}
+		location = loc
+	}
+
+	d := &DailyDriver{
+		config:   config,
+		path:     path,
+		days:     config.FileConfig.Days,
+		location: location,
+		now:      time.Now,
+		remove:   os.Remove,
+	}
+
+	if err := d.switchFile(d.now().In(location).Format(dailyDateFormat)); err != nil {
+		return nil, err
+	}
+	if err := d.pruneFiles(); err != nil {
+		d.current.Close()
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// Log writes a log entry to the file for the current day. Old files are
+// removed after switching days; a failure to remove them is returned after
+// the entry has been written.
+func (d *DailyDriver) Log(entry *Entry) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var pruneErr error
+	if date := d.now().In(d.location).Format(dailyDateFormat); date != d.date {
+		if err := d.switchFile(date); err != nil {
+			return err
+		}
+		pruneErr = d.pruneFiles()
+	}
+
+	if err := d.current.Log(entry); err != nil {
+		return err
+	}
+	return pruneErr
+}
+
+// switchFile closes the current file and opens the one for date.
+// The caller must hold d.mu.
+func (d *DailyDriver) switchFile(date string) error {
+	fileConfig := *d.config.FileConfig
+	fileConfig.Path = datedName(d.path, date)
+
+	config := d.config
+	config.FileConfig = &fileConfig
+
+	driver, err := NewFileDriver(config)
+	if err != nil {
+		return err
+	}
+
+	if d.current != nil {
+		_ = d.current.Close()
+	}
+
+	d.current = driver.(*FileDriver)
+	d.date = date
+
+	return nil
+}
+
+// pruneFiles removes daily files beyond the configured number of days (0 = keep all).
+// The caller must hold d.mu.
+func (d *DailyDriver) pruneFiles() error {
+	if d.days <= 0 {
+		return nil
+	}
+
+	files, err := listDailyFiles(d.path)
+	if err != nil {
+		return err
+	}
+
+	var lastErr error
+	for i := d.days; i < len(files); i++ {
+		if err := d.remove(files[i]); err != nil && !os.IsNotExist(err) {
+			lastErr = fmt.Errorf("failed to remove old log file: %w", err)
+		}
+	}
+	return lastErr
+}
+
+// datedName returns the daily file name for path,
+// e.g. logs/app.log -> logs/app-2024-01-15.log
+func datedName(path, date string) string {
+	dir := filepath.Dir(path)
+	base := filepath.Base(path)
+	ext := filepath.Ext(base)
+	prefix := strings.TrimSuffix(base, ext)
+	return filepath.Join(dir, fmt.Sprintf("%s-%s%s", prefix, date, ext))
+}
+
+// listDailyFiles returns the daily files of path, newest first
+func listDailyFiles(path string) ([]string, error) {
+	dir := filepath.Dir(path)
+	base := filepath.Base(path)
+	ext := filepath.Ext(base)
+	prefix := strings.TrimSuffix(base, ext) + "-"
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read log directory: %w", err)
+	}
+
+	var dates []string
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, ext) {
+			continue
+		}
+
+		date := strings.TrimSuffix(strings.TrimPrefix(name, prefix), ext)
+		if _, err := time.Parse(dailyDateFormat, date); err != nil {
+			continue
+		}
+		dates = append(dates, date)
+	}
+
+	// ISO dates sort lexically
+	sort.Sort(sort.Reverse(sort.StringSlice(dates)))
+
+	files := make([]string, 0, len(dates))
+	for _, date := range dates {
+		files = append(files, datedName(path, date))
+	}
+	return files, nil
+}
+
+// Close closes the current file
+func (d *DailyDriver) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.current != nil {
+		return d.current.Close()
+	}
+	return nil
+}
+
+// Reopen reopens the current day's file at its path
+func (d *DailyDriver) Reopen() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.current != nil {
+		return d.current.Reopen()
+	}
+	return nil
+}
+
+// Name returns the driver name
+func (d *DailyDriver) Name() string {
+	return "daily"
+}
+
+// Flush ensures all data is written to disk
+func (d *DailyDriver) Flush() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.current != nil {
+		return d.current.Flush()
+	}
+	return nil
+}
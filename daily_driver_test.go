@@ -0,0 +1,189 @@
+package golog
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewDailyDriver(t *testing.T) {
+	logPath := filepath.Join(t.TempDir(), "laravel.log")
+
+	driver, err := NewDailyDriver(NewDailyChannelConfig(logPath, 14))
+	if err != nil {
+		t.Fatalf("NewDailyDriver failed: %v", err)
+	}
+	defer driver.Close()
+
+	if driver.Name() != "daily" {
+		t.Errorf("Expected driver name 'daily', got %q", driver.Name())
+	}
+
+	today := datedName(logPath, time.Now().Format(dailyDateFormat))
+	if _, err := os.Stat(today); err != nil {
+		t.Errorf("Expected today's file %q to be created: %v", today, err)
+	}
+}
+
+func TestNewDailyDriver_NoConfig(t *testing.T) {
+	_, err := NewDailyDriver(ChannelConfig{Driver: "daily"})
+	if err == nil {
+		t.Error("Expected error for missing FileConfig")
+	}
+}
+
+func TestNewDailyDriver_InvalidTimezone(t *testing.T) {
+	config := NewDailyChannelConfig(filepath.Join(t.TempDir(), "app.log"), 7, WithFileTimezone("Not/AZone"))
+
+	_, err := NewDailyDriver(config)
+	if err == nil {
+		t.Error("Expected error for invalid timezone")
+	}
+}
+
+func TestDailyDriver_SwitchesAtMidnight(t *testing.T) {
+	tempDir := t.TempDir()
+	logPath := filepath.Join(tempDir, "app.log")
+
+	config := NewDailyChannelConfig(logPath, 0, WithFileTimezone("UTC"))
+	driver, err := NewDailyDriver(config)
+	if err != nil {
+		t.Fatalf("NewDailyDriver failed: %v", err)
+	}
+
+	dd := driver.(*DailyDriver)
+	now := time.Date(2024, 1, 15, 23, 59, 0, 0, time.UTC)
+	dd.now = func() time.Time { return now }
+
+	if err := dd.Log(NewEntry(InfoLevel, "before midnight")); err != nil {
+		t.Fatalf("Log failed: %v", err)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if err := dd.Log(NewEntry(InfoLevel, "after midnight")); err != nil {
+		t.Fatalf("Log failed: %v", err)
+	}
+	dd.Close()
+
+	first, err := os.ReadFile(filepath.Join(tempDir, "app-2024-01-15.log"))
+	if err != nil {
+		t.Fatalf("Failed to read first day: %v", err)
+	}
+	if !strings.Contains(string(first), "before midnight") || strings.Contains(string(first), "after midnight") {
+		t.Errorf("Unexpected first day content: %q", first)
+	}
+
+	second, err := os.ReadFile(filepath.Join(tempDir, "app-2024-01-16.log"))
+	if err != nil {
+		t.Fatalf("Failed to read second day: %v", err)
+	}
+	if !strings.Contains(string(second), "after midnight") {
+		t.Errorf("Unexpected second day content: %q", second)
+	}
+}
+
+func TestDailyDriver_Timezone(t *testing.T) {
+	tempDir := t.TempDir()
+	logPath := filepath.Join(tempDir, "app.log")
+
+	driver, err := NewDailyDriver(NewDailyChannelConfig(logPath, 0, WithFileTimezone("Asia/Riyadh")))
+	if err != nil {
+		t.Fatalf("NewDailyDriver failed: %v", err)
+	}
+
+	dd := driver.(*DailyDriver)
+	// 22:30 UTC is already the next day in Riyadh (UTC+3)
+	dd.now = func() time.Time { return time.Date(2024, 1, 15, 22, 30, 0, 0, time.UTC) }
+
+	if err := dd.Log(NewEntry(InfoLevel, "message")); err != nil {
+		t.Fatalf("Log failed: %v", err)
+	}
+	dd.Close()
+
+	if _, err := os.Stat(filepath.Join(tempDir, "app-2024-01-16.log")); err != nil {
+		t.Errorf("Expected file dated in the configured timezone: %v", err)
+	}
+}
+
+func TestDailyDriver_Days(t *testing.T) {
+	tempDir := t.TempDir()
+	logPath := filepath.Join(tempDir, "app.log")
+
+	for _, date := range []string{"2024-01-10", "2024-01-11", "2024-01-12", "2024-01-13"} {
+		if err := os.WriteFile(datedName(logPath, date), []byte("old\n"), 0644); err != nil {
+			t.Fatalf("WriteFile failed: %v", err)
+		}
+	}
+
+	driver, err := NewDailyDriver(NewDailyChannelConfig(logPath, 2))
+	if err != nil {
+		t.Fatalf("NewDailyDriver failed: %v", err)
+	}
+	driver.Close()
+
+	files, err := listDailyFiles(logPath)
+	if err != nil {
+		t.Fatalf("listDailyFiles failed: %v", err)
+	}
+
+	want := []string{
+		datedName(logPath, time.Now().Format(dailyDateFormat)),
+		datedName(logPath, "2024-01-13"),
+	}
+	if len(files) != len(want) {
+		t.Fatalf("Expected files %v, got %v", want, files)
+	}
+	for i := range want {
+		if files[i] != want[i] {
+			t.Errorf("files[%d] = %q, want %q", i, files[i], want[i])
+		}
+	}
+}
+
+func TestDailyDriver_PruneFailure(t *testing.T) {
+	logPath := filepath.Join(t.TempDir(), "app.log")
+
+	driver, err := NewDailyDriver(NewDailyChannelConfig(logPath, 1, WithFileTimezone("UTC")))
+	if err != nil {
+		t.Fatalf("NewDailyDriver failed: %v", err)
+	}
+	defer driver.Close()
+
+	dd := driver.(*DailyDriver)
+	dd.now = func() time.Time { return time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC) }
+	dd.remove = func(name string) error { return os.ErrPermission }
+
+	err = dd.Log(NewEntry(InfoLevel, "first of the day"))
+	if err == nil || !strings.Contains(err.Error(), "failed to remove old log file") {
+		t.Errorf("Expected prune error, got %v", err)
+	}
+
+	content, _ := os.ReadFile(datedName(logPath, "2099-01-01"))
+	if !strings.Contains(string(content), "first of the day") {
+		t.Errorf("Expected entry to be written despite the prune error, got %q", content)
+	}
+}
+
+func TestDailyDriver_LineFormat(t *testing.T) {
+	tempDir := t.TempDir()
+	logPath := filepath.Join(tempDir, "app.log")
+
+	driver, err := NewDailyDriver(NewDailyChannelConfig(logPath, 0))
+	if err != nil {
+		t.Fatalf("NewDailyDriver failed: %v", err)
+	}
+
+	entry := NewEntry(WarningLevel, "disk almost full")
+	entry.SetChannel("daily")
+	if err := driver.Log(entry); err != nil {
+		t.Fatalf("Log failed: %v", err)
+	}
+	driver.Close()
+
+	content, _ := os.ReadFile(datedName(logPath, time.Now().Format(dailyDateFormat)))
+	if !strings.Contains(string(content), "daily.WARNING: disk almost full") {
+		t.Errorf("Expected Laravel line format, got %q", content)
+	}
+}
@@ -12,13 +12,30 @@ type Driver interface {
 	Name() string
 }
 
+// Reopener is implemented by drivers that write to files and can reopen them
+// at their configured path, e.g. after an external logrotate moved them away
+type Reopener interface {
+	Reopen() error
+}
+
+// AsyncErrorReporter is implemented by drivers that deliver entries in the
+// background and therefore cannot return delivery errors from Log. The
+// manager sets the callback when the channel is created, before any Log call.
+type AsyncErrorReporter interface {
+	SetErrorCallback(fn func(entry *Entry, err error))
+}
+
 // DriverFactory creates a driver from configuration
 type DriverFactory func(config ChannelConfig) (Driver, error)
 
 // Built-in driver factories
 var driverFactories = map[string]DriverFactory{
-	"file":  NewFileDriver,
-	"slack": NewSlackDriver,
+	"file":    NewFileDriver,
+	"daily":   NewDailyDriver,
+	"slack":   NewSlackDriver,
+	"stdout":  NewStdoutDriver,
+	"stderr":  NewStderrDriver,
+	"rfc5424": NewRFC5424Driver,
 }
 
 // RegisterDriver registers a custom driver factory
@@ -0,0 +1,126 @@
+package golog
+
+// ErrorHandler receives errors of drivers failing to write entries,
+// including errors reported asynchronously (see AsyncErrorReporter)
+type ErrorHandler func(err *DriverError)
+
+// SetErrorHandler sets the function called whenever a channel's driver fails
+// to write an entry. It may be called concurrently. Pass nil to remove it.
+func (m *Manager) SetErrorHandler(handler ErrorHandler) {
+	if handler == nil {
+		m.errorHandler.Store(nil)
+		return
+	}
+	m.errorHandler.Store(&handler)
+}
+
+// SetFallbackChannel sets the channel that receives entries other channels
+// failed to write, overriding Config.FallbackChannel. Pass "" to disable it.
+// Failures of the fallback channel itself are only reported, not rerouted.
+func (m *Manager) SetFallbackChannel(name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if name != "" {
+		if _, exists := m.config.Channels[name]; !exists {
+			return ErrChannelNotFound
+		}
+	}
+	m.setFallback(name)
+	return nil
+}
+
+// setFallback sets the fallback channel name
+func (m *Manager) setFallback(name string) {
+	m.fallbackChannel.Store(&name)
+}
+
+// Failures returns the number of entries each channel's driver failed to
+// write since the manager was created
+func (m *Manager) Failures() map[string]uint64 {
+	m.failuresMu.Lock()
+	defer m.failuresMu.Unlock()
+
+	failures := make(map[string]uint64, len(m.failures))
+	for name, n := range m.failures {
+		failures[name] = n
+	}
+	return failures
+}
+
+// process runs the channel's processors and writes the entry to its driver.
+// Holding the read lock makes a reload wait for this write before closing the driver.
+func (c *LogChannel) process(entry *Entry) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if entry = runProcessors(entry, c.processors); entry == nil {
+		return nil
+	}
+	return c.driver.Log(entry)
+}
+
+// deliver writes an already processed entry to the channel's driver
+func (c *LogChannel) deliver(entry *Entry) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.driver.Log(entry)
+}
+
+// reportAsyncErrors routes errors a driver reports in the background to the
+// error handling of the named channel
+func (m *Manager) reportAsyncErrors(name string, driver Driver) {
+	if r, ok := driver.(AsyncErrorReporter); ok {
+		r.SetErrorCallback(func(entry *Entry, err error) {
+			m.handleDriverError(name, entry, err)
+		})
+	}
+}
+
+// handleDriverError counts the failure, calls the error handler and writes
+// the entry to the fallback channel unless the manager is closed
+func (m *Manager) handleDriverError(channel string, entry *Entry, err error) {
+	m.failuresMu.Lock()
+	m.failures[channel]++
+	m.failuresMu.Unlock()
+
+	// Not under m.mu: async workers get here while Close or Reload holds it
+	var handler ErrorHandler
+	if h := m.errorHandler.Load(); h != nil {
+		handler = *h
+	}
+	fallback := *m.fallbackChannel.Load()
+
+	if handler != nil {
+		handler(&DriverError{Channel: channel, Entry: entry, Err: err})
+	}
+
+	// After Close, Channel would create a fallback channel nothing closes
+	if fallback == "" || fallback == channel || m.closed.Load() {
+		return
+	}
+
+	logger, chErr := m.Channel(fallback)
+	if chErr != nil {
+		return
+	}
+
+	// Mark the copy so the fallback output shows where it came from
+	copied := *entry
+	copied.Extra = make(map[string]any, len(entry.Extra)+2)
+	for k, v := range entry.Extra {
+		copied.Extra[k] = v
+	}
+	copied.Extra["failed_channel"] = channel
+	copied.Extra["driver_error"] = err.Error()
+
+	if fbErr := logger.channel.deliver(&copied); fbErr != nil {
+		m.failuresMu.Lock()
+		m.failures[fallback]++
+		m.failuresMu.Unlock()
+
+		if handler != nil {
+			handler(&DriverError{Channel: fallback, Entry: &copied, Err: fbErr})
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package golog
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// failingDriver returns err from every Log call
+type failingDriver struct {
+	err error
+}
+
+func (d *failingDriver) Log(entry *Entry) error { return d.err }
+func (d *failingDriver) Close() error           { return nil }
+func (d *failingDriver) Name() string           { return "failing" }
+
+// registerFailingDriver registers a "failing" driver failing with "disk full"
+func registerFailingDriver(t *testing.T) {
+	t.Helper()
+
+	RegisterDriver("failing", func(config ChannelConfig) (Driver, error) {
+		return &failingDriver{err: errors.New("disk full")}, nil
+	})
+	t.Cleanup(func() { delete(driverFactories, "failing") })
+}
+
+// errorChannels are failing channels and a "backup" mock channel
+var errorChannels = map[string]ChannelConfig{
+	"test":   {Driver: "failing", Level: "debug"},
+	"backup": {Driver: "mock", Level: "debug"},
+	"broken": {Driver: "failing", Level: "debug"},
+}
+
+func TestManager_ErrorHandler(t *testing.T) {
+	registerFailingDriver(t)
+	manager, _ := newMockManager(t, errorChannels)
+
+	var got []*DriverError
+	manager.SetErrorHandler(func(err *DriverError) {
+		got = append(got, err)
+	})
+
+	logger, _ := manager.Channel("test")
+	logger.Error("payment failed")
+
+	if len(got) != 1 {
+		t.Fatalf("Expected 1 driver error, got %d", len(got))
+	}
+	if got[0].Channel != "test" || got[0].Entry.Message != "payment failed" {
+		t.Errorf("Unexpected driver error %+v", got[0])
+	}
+	if got[0].Error() != "golog: channel [test]: disk full" || errors.Unwrap(got[0]).Error() != "disk full" {
+		t.Errorf("Unexpected error message %q", got[0].Error())
+	}
+}
+
+func TestManager_FallbackChannel(t *testing.T) {
+	registerFailingDriver(t)
+	manager, backup := newMockManager(t, errorChannels)
+	if err := manager.SetFallbackChannel("backup"); err != nil {
+		t.Fatalf("SetFallbackChannel failed: %v", err)
+	}
+
+	logger, _ := manager.Channel("test")
+	logger.Error("payment failed")
+
+	if len(backup.entries) != 1 {
+		t.Fatalf("Expected entry in fallback channel, got %d", len(backup.entries))
+	}
+	entry := backup.entries[0]
+	if entry.Message != "payment failed" || entry.Extra["failed_channel"] != "test" || entry.Extra["driver_error"] != "disk full" {
+		t.Errorf("Unexpected fallback entry %+v", entry)
+	}
+
+	if failures := manager.Failures(); failures["test"] != 1 {
+		t.Errorf("Expected 1 failure for test, got %v", failures)
+	}
+}
+
+func TestManager_FallbackChannelFails(t *testing.T) {
+	registerFailingDriver(t)
+	manager, _ := newMockManager(t, errorChannels)
+	if err := manager.SetFallbackChannel("broken"); err != nil {
+		t.Fatalf("SetFallbackChannel failed: %v", err)
+	}
+
+	var channels []string
+	manager.SetErrorHandler(func(err *DriverError) {
+		channels = append(channels, err.Channel)
+	})
+
+	logger, _ := manager.Channel("test")
+	logger.Error("first")
+
+	if strings.Join(channels, ",") != "test,broken" {
+		t.Errorf("Expected errors for test then broken, got %v", channels)
+	}
+	if failures := manager.Failures(); failures["test"] != 1 || failures["broken"] != 1 {
+		t.Errorf("Unexpected failures %v", failures)
+	}
+
+	// The fallback channel's own failures are not rerouted
+	broken, _ := manager.Channel("broken")
+	broken.Error("second")
+	if failures := manager.Failures(); failures["broken"] != 2 {
+		t.Errorf("Expected 2 failures for broken, got %v", failures)
+	}
+}
+
+func TestManager_SetFallbackChannel_Undefined(t *testing.T) {
+	registerFailingDriver(t)
+	manager, _ := newMockManager(t, errorChannels)
+
+	if err := manager.SetFallbackChannel("nope"); !errors.Is(err, ErrChannelNotFound) {
+		t.Errorf("Expected ErrChannelNotFound, got %v", err)
+	}
+}
+
+func TestManager_AsyncSlackErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	manager, err := NewManager(&Config{
+		Default: "slack",
+		Channels: map[string]ChannelConfig{
+			"slack": NewSlackChannelConfig(server.URL, WithSlackAsync(true)),
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewManager failed: %v", err)
+	}
+	defer manager.Close()
+
+	var mu sync.Mutex
+	var got *DriverError
+	manager.SetErrorHandler(func(err *DriverError) {
+		mu.Lock()
+		got = err
+		mu.Unlock()
+	})
+
+	logger, _ := manager.Default()
+	logger.Error("webhook broken")
+
+	waitFor(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return got != nil
+	})
+
+	mu.Lock()
+	defer mu.Unlock()
+	if got.Channel != "slack" || got.Entry.Message != "webhook broken" || !strings.Contains(got.Err.Error(), "500") {
+		t.Errorf("Unexpected async driver error %v", got)
+	}
+}
+
+func TestManager_Close_FallbackNotRecreated(t *testing.T) {
+	inner := newGatedDriver()
+	inner.err = errors.New("disk full")
+	registerGatedDriver(t, inner)
+
+	manager, err := NewManager(&Config{
+		Default:         "test",
+		FallbackChannel: "fb",
+		Channels: map[string]ChannelConfig{
+			"test": {Driver: "gated", AsyncConfig: &AsyncConfig{QueueSize: 1}},
+			"fb":   NewFileChannelConfig(filepath.Join(t.TempDir(), "fb.log")),
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewManager failed: %v", err)
+	}
+
+	var mu sync.Mutex
+	handled := 0
+	manager.SetErrorHandler(func(err *DriverError) {
+		mu.Lock()
+		defer mu.Unlock()
+		handled++
+	})
+
+	// Leave entries to fail while Close drains the channel
+	logger, _ := manager.Default()
+	logger.Info("busy")
+	<-inner.started
+	logger.Info("queued")
+
+	closed := make(chan error)
+	go func() { closed <- manager.Close() }()
+	time.Sleep(20 * time.Millisecond)
+	close(inner.release)
+	<-closed
+
+	mu.Lock()
+	defer mu.Unlock()
+	if handled != 2 {
+		t.Errorf("Expected 2 driver errors, got %d", handled)
+	}
+
+	manager.mu.RLock()
+	defer manager.mu.RUnlock()
+	if len(manager.channels) != 0 {
+		t.Errorf("Expected no channels after Close, got %d", len(manager.channels))
+	}
+}
@@ -11,7 +11,10 @@ func TestGetDriverFactory(t *testing.T) {
 		expected bool
 	}{
 		{"file driver exists", "file", true},
+		{"daily driver exists", "daily", true},
 		{"slack driver exists", "slack", true},
+		{"stdout driver exists", "stdout", true},
+		{"stderr driver exists", "stderr", true},
 		{"unknown driver", "unknown", false},
 		{"custom driver", "custom", false},
 	}
@@ -89,3 +92,22 @@ func (d *mockDriver) Name() string {
 	return d.name
 }
 
+// newMockManager returns a manager with the given channels and "test" as the
+// default channel; channels using the "mock" driver record entries in the returned mockDriver
+func newMockManager(t *testing.T, channels map[string]ChannelConfig) (*Manager, *mockDriver) {
+	t.Helper()
+
+	driver := &mockDriver{name: "mock"}
+	RegisterDriver("mock", func(config ChannelConfig) (Driver, error) {
+		return driver, nil
+	})
+	t.Cleanup(func() { delete(driverFactories, "mock") })
+
+	manager, err := NewManager(&Config{Default: "test", Channels: channels})
+	if err != nil {
+		t.Fatalf("NewManager failed: %v", err)
+	}
+	t.Cleanup(func() { manager.Close() })
+
+	return manager, driver
+}
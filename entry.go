@@ -27,6 +27,12 @@ type Entry struct {
 
 	// Channel is the name of the log channel
 	Channel string `json:"channel,omitempty"`
+
+	// Extra contains metadata attached alongside the call-site context (Monolog's "extra")
+	Extra map[string]any `json:"extra,omitempty"`
+
+	// Caller is the code location that logged the entry, when caller capture is enabled
+	Caller *CallerInfo `json:"caller,omitempty"`
 }
 
 // ExceptionInfo contains structured exception/error information
@@ -63,6 +69,15 @@ func (e *Entry) With(key string, value any) *Entry {
 	return e
 }
 
+// WithExtra adds a single extra key-value pair, as set by processors
+func (e *Entry) WithExtra(key string, value any) *Entry {
+	if e.Extra == nil {
+		e.Extra = make(map[string]any)
+	}
+	e.Extra[key] = value
+	return e
+}
+
 // WithError adds error information to the entry
 func (e *Entry) WithError(err error) *Entry {
 	if err == nil {
@@ -77,10 +92,10 @@ func (e *Entry) WithError(err error) *Entry {
 	// Capture stack trace
 	e.Exception.Trace = captureStackTrace(3) // Skip captureStackTrace, WithError, and caller
 
-	// Try to get file and line from stack
-	if _, file, line, ok := runtime.Caller(1); ok {
-		e.Exception.File = file
-		e.Exception.Line = line
+	// Use the user's call site rather than golog's own frames
+	if caller := captureCaller(); caller != nil {
+		e.Exception.File = caller.File
+		e.Exception.Line = caller.Line
 	}
 
 	return e
@@ -125,8 +140,8 @@ func captureStackTrace(skip int) []string {
 
 	for {
 		frame, more := frames.Next()
-		// Skip runtime frames
-		if !strings.Contains(frame.File, "runtime/") {
+		// Skip runtime frames and golog's own frames
+		if !strings.Contains(frame.File, "runtime/") && !isInternalFrame(frame) {
 			trace = append(trace, fmt.Sprintf("%s:%d (%s)", frame.File, frame.Line, frame.Function))
 		}
 		if !more {
@@ -1,6 +1,9 @@
 package golog
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	// ErrNotInitialized is returned when the log manager is not initialized
@@ -13,3 +16,24 @@ var (
 	ErrDriverNotSupported = errors.New("golog: driver not supported")
 )
 
+// DriverError is a failure of a channel's driver to write an entry
+type DriverError struct {
+	// Channel is the name of the channel whose driver failed
+	Channel string
+
+	// Entry is the entry that could not be written
+	Entry *Entry
+
+	// Err is the error returned or reported by the driver
+	Err error
+}
+
+// Error returns the channel name and driver error
+func (e *DriverError) Error() string {
+	return fmt.Sprintf("golog: channel [%s]: %v", e.Channel, e.Err)
+}
+
+// Unwrap returns the driver error
+func (e *DriverError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,190 @@
+package golog
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// defaultFallbackCooldown is how long a failing channel is skipped when
+// FallbackConfig.Cooldown is not set
+const defaultFallbackCooldown = 30 * time.Second
+
+// FallbackDriver writes each entry to the first of its channels that succeeds,
+// in order. Unlike StackDriver, later channels are only used when earlier ones
+// fail. With a FailureThreshold, a channel failing that many times in a row is
+// skipped for Cooldown, unless every channel is being skipped.
+//
+// Channels should be synchronous: async Slack channels and channels with an
+// AsyncConfig report success before writing, so the fallback never triggers for them.
+type FallbackDriver struct {
+	mu        sync.Mutex
+	members   []*fallbackMember
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+}
+
+// fallbackMember tracks the health of one channel of a FallbackDriver
+type fallbackMember struct {
+	name      string
+	driver    Driver
+	failures  int
+	skipUntil time.Time
+}
+
+// createFallbackChannel creates a fallback channel from configuration
+func (m *Manager) createFallbackChannel(cfg *Config, name string, config ChannelConfig) (*LogChannel, error) {
+	if config.StackConfig == nil || len(config.StackConfig.Channels) == 0 {
+		return nil, fmt.Errorf("fallback channel [%s] requires channel list", name)
+	}
+
+	fallbackDriver, err := m.newFallbackDriver(cfg, config)
+	if err != nil {
+		return nil, err
+	}
+	driver, err := m.wrapAsync(name, fallbackDriver, config)
+	if err != nil {
+		return nil, err
+	}
+	m.reportAsyncErrors(name, driver)
+
+	return newLogChannel(name, driver, mergeMemberSettings(cfg, config))
+}
+
+// newFallbackDriver creates the drivers of a fallback's channels in order
+func (m *Manager) newFallbackDriver(cfg *Config, config ChannelConfig) (*FallbackDriver, error) {
+	d := &FallbackDriver{
+		cooldown: defaultFallbackCooldown,
+		now:      time.Now,
+	}
+	if fc := config.FallbackConfig; fc != nil {
+		d.threshold = fc.FailureThreshold
+		if fc.Cooldown > 0 {
+			d.cooldown = fc.Cooldown
+		}
+	}
+
+	for _, chName := range config.StackConfig.Channels {
+		chConfig, exists := cfg.Channels[chName]
+		if !exists {
+			d.Close()
+			return nil, fmt.Errorf("channel [%s] in fallback is not defined", chName)
+		}
+
+		driver, err := m.newMemberDriver(cfg, chName, chConfig)
+		if err != nil {
+			d.Close()
+			return nil, fmt.Errorf("failed to create driver [%s]: %w", chConfig.Driver, err)
+		}
+		d.members = append(d.members, &fallbackMember{name: chName, driver: driver})
+	}
+
+	return d, nil
+}
+
+// Log writes the entry to the first healthy channel that accepts it. Channels
+// in cool-down are tried last. The error lists every failure if all fail.
+func (d *FallbackDriver) Log(entry *Entry) error {
+	var errs []error
+
+	healthy, skipped := d.partition()
+	for _, group := range [][]*fallbackMember{healthy, skipped} {
+		for _, member := range group {
+			err := member.driver.Log(entry)
+			d.record(member, err)
+			if err == nil {
+				return nil
+			}
+			errs = append(errs, fmt.Errorf("channel [%s]: %w", member.name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// partition splits the members into those to try first and those in cool-down
+func (d *FallbackDriver) partition() (healthy, skipped []*fallbackMember) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	for _, member := range d.members {
+		if now.Before(member.skipUntil) {
+			skipped = append(skipped, member)
+		} else {
+			healthy = append(healthy, member)
+		}
+	}
+	return healthy, skipped
+}
+
+// record updates a member's health after a write
+func (d *FallbackDriver) record(member *fallbackMember, err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if err == nil {
+		member.failures = 0
+		member.skipUntil = time.Time{}
+		return
+	}
+
+	member.failures++
+	if d.threshold > 0 && member.failures >= d.threshold {
+		member.skipUntil = d.now().Add(d.cooldown)
+	}
+}
+
+// Healthy reports, per channel name, whether the channel is currently tried first
+func (d *FallbackDriver) Healthy() map[string]bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	health := make(map[string]bool, len(d.members))
+	for _, member := range d.members {
+		health[member.name] = !now.Before(member.skipUntil)
+	}
+	return health
+}
+
+// Close closes all channel drivers
+func (d *FallbackDriver) Close() error {
+	var errs []error
+	for _, member := range d.members {
+		if err := member.driver.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Reopen reopens the files of channel drivers that support it
+func (d *FallbackDriver) Reopen() error {
+	var errs []error
+	for _, member := range d.members {
+		if r, ok := member.driver.(Reopener); ok {
+			if err := r.Reopen(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// SetErrorCallback forwards the callback to channel drivers that report
+// asynchronous errors
+func (d *FallbackDriver) SetErrorCallback(fn func(entry *Entry, err error)) {
+	for _, member := range d.members {
+		if r, ok := member.driver.(AsyncErrorReporter); ok {
+			r.SetErrorCallback(fn)
+		}
+	}
+}
+
+// Name returns the driver name
+func (d *FallbackDriver) Name() string {
+	return "fallback"
+}
@@ -0,0 +1,179 @@
+package golog
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// flakyDriver fails while failing is set and records delivered messages
+type flakyDriver struct {
+	mu       sync.Mutex
+	failing  bool
+	calls    int
+	messages []string
+}
+
+func (d *flakyDriver) Log(entry *Entry) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.calls++
+	if d.failing {
+		return errors.New("unavailable")
+	}
+	d.messages = append(d.messages, entry.Message)
+	return nil
+}
+
+func (d *flakyDriver) Close() error { return nil }
+func (d *flakyDriver) Name() string { return "flaky" }
+
+func (d *flakyDriver) setFailing(failing bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.failing = failing
+}
+
+func newFallbackManager(t *testing.T, options ...FallbackOption) (*Manager, map[string]*flakyDriver) {
+	t.Helper()
+
+	drivers := map[string]*flakyDriver{}
+	RegisterDriver("flaky", func(config ChannelConfig) (Driver, error) {
+		d := &flakyDriver{}
+		drivers[config.AppName] = d
+		return d, nil
+	})
+	t.Cleanup(func() { delete(driverFactories, "flaky") })
+
+	manager, err := NewManager(&Config{
+		Default: "app",
+		Channels: map[string]ChannelConfig{
+			"app":       NewFallbackChannelConfig([]string{"primary", "secondary"}, options...),
+			"primary":   {Driver: "flaky", AppName: "primary"},
+			"secondary": {Driver: "flaky", AppName: "secondary"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewManager failed: %v", err)
+	}
+	t.Cleanup(func() { manager.Close() })
+
+	// Channels are created lazily; create the members now
+	if _, err := manager.Default(); err != nil {
+		t.Fatalf("Default failed: %v", err)
+	}
+
+	return manager, drivers
+}
+
+func TestFallbackDriver_FirstSuccessWins(t *testing.T) {
+	manager, drivers := newFallbackManager(t)
+
+	logger, _ := manager.Default()
+	logger.Info("hello")
+
+	if got := drivers["primary"].messages; len(got) != 1 || got[0] != "hello" {
+		t.Errorf("Expected entry in primary, got %v", got)
+	}
+	if got := drivers["secondary"].calls; got != 0 {
+		t.Errorf("Expected secondary to be unused, got %d calls", got)
+	}
+}
+
+func TestFallbackDriver_FallsThrough(t *testing.T) {
+	manager, drivers := newFallbackManager(t)
+	drivers["primary"].setFailing(true)
+
+	var handled []*DriverError
+	manager.SetErrorHandler(func(err *DriverError) { handled = append(handled, err) })
+
+	logger, _ := manager.Default()
+	logger.Info("hello")
+
+	if got := drivers["secondary"].messages; len(got) != 1 || got[0] != "hello" {
+		t.Errorf("Expected entry in secondary, got %v", got)
+	}
+	if len(handled) != 0 {
+		t.Errorf("Expected no driver error when a channel succeeds, got %v", handled)
+	}
+}
+
+func TestFallbackDriver_AllFail(t *testing.T) {
+	manager, drivers := newFallbackManager(t)
+	drivers["primary"].setFailing(true)
+	drivers["secondary"].setFailing(true)
+
+	var handled []*DriverError
+	manager.SetErrorHandler(func(err *DriverError) { handled = append(handled, err) })
+
+	logger, _ := manager.Default()
+	logger.Info("hello")
+
+	if len(handled) != 1 {
+		t.Fatalf("Expected 1 driver error, got %d", len(handled))
+	}
+	msg := handled[0].Error()
+	for _, w := range []string{"channel [primary]: unavailable", "channel [secondary]: unavailable"} {
+		if !strings.Contains(msg, w) {
+			t.Errorf("Expected error to contain %q, got %q", w, msg)
+		}
+	}
+}
+
+func TestFallbackDriver_Cooldown(t *testing.T) {
+	manager, drivers := newFallbackManager(t, WithFallbackHealth(2, time.Minute))
+
+	logger, _ := manager.Default()
+	manager.mu.RLock()
+	fallback := manager.channels["app"].driver.(*FallbackDriver)
+	manager.mu.RUnlock()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	fallback.now = func() time.Time { return now }
+
+	primary := drivers["primary"]
+	primary.setFailing(true)
+	logger.Info("one")
+	logger.Info("two")
+
+	if fallback.Healthy()["primary"] {
+		t.Fatal("Expected primary to be skipped after reaching the failure threshold")
+	}
+
+	primary.setFailing(false)
+	logger.Info("three")
+	if primary.calls != 2 {
+		t.Errorf("Expected primary to be skipped during cool-down, got %d calls", primary.calls)
+	}
+
+	now = now.Add(time.Minute)
+	logger.Info("four")
+	if got := primary.messages; len(got) != 1 || got[0] != "four" {
+		t.Errorf("Expected primary to be retried after cool-down, got %v", got)
+	}
+	if !fallback.Healthy()["primary"] {
+		t.Error("Expected primary to be healthy after a successful write")
+	}
+	if got := drivers["secondary"].messages; len(got) != 3 {
+		t.Errorf("Expected 3 entries in secondary, got %v", got)
+	}
+}
+
+func TestFallbackDriver_RetriesSkippedWhenAllFail(t *testing.T) {
+	manager, drivers := newFallbackManager(t, WithFallbackHealth(1, time.Minute))
+
+	logger, _ := manager.Default()
+	primary := drivers["primary"]
+	primary.setFailing(true)
+	logger.Info("one")
+
+	primary.setFailing(false)
+	drivers["secondary"].setFailing(true)
+	logger.Info("two")
+
+	if got := primary.messages; len(got) != 1 || got[0] != "two" {
+		t.Errorf("Expected skipped primary to be tried last, got %v", got)
+	}
+}
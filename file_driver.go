@@ -1,18 +1,65 @@
 package golog
 
 import (
+	"compress/gzip"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
-// FileDriver writes log entries to a file
+// backupTimeFormat is the timestamp layout used in rotated file names
+const backupTimeFormat = "2006-01-02T15-04-05.000"
+
+// megabyte is the unit used by FileConfig.MaxSize
+const megabyte = 1024 * 1024
+
+// compressSuffix is appended to rotated files when FileConfig.Compress is set
+const compressSuffix = ".gz"
+
+// FileDriver writes log entries to a file. FileDrivers of the same path, such
+// as a channel used both directly and inside a stack, share one fileWriter;
+// the rotation settings of the most recently opened one apply.
 type FileDriver struct {
-	mu         sync.Mutex
+	formatter Formatter
+	config    *FileConfig
+	w         *fileWriter
+	closed    atomic.Bool
+}
+
+// fileWriters holds the open log files by absolute path (guarded by fileWritersMu)
+var (
+	fileWritersMu sync.Mutex
+	fileWriters   = make(map[string]*fileWriter)
+)
+
+// fileWriter appends to a log file and rotates it by size. It is shared by all
+// FileDrivers of its path and closed when the last of them is closed.
+type fileWriter struct {
+	mu  sync.Mutex
+	key string
+
+	// configs holds the settings of each FileDriver using the writer, newest
+	// last (guarded by fileWritersMu)
+	configs []*FileConfig
+
 	file       *os.File
 	path       string
-	dateFormat string
+	size       int64
+	maxSize    int64
+	maxBackups int
+	maxAge     time.Duration
+	compress   bool
+
+	// The mill goroutine compresses and removes backups off the Log path
+	millOnce sync.Once
+	millCh   chan struct{}
+	millDone chan struct{}
 }
 
 // NewFileDriver creates a new file driver from configuration
@@ -26,110 +73,409 @@ func NewFileDriver(config ChannelConfig) (Driver, error) {
 		path = "logs/app.log"
 	}
 
-	dateFormat := config.FileConfig.DateFormat
-	if dateFormat == "" {
-		dateFormat = "2006-01-02 15:04:05"
+	formatter, err := NewFormatter(config)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := acquireFileWriter(path, config.FileConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileDriver{formatter: formatter, config: config.FileConfig, w: w}, nil
+}
+
+// acquireFileWriter returns the writer of path, opening it if no FileDriver
+// uses it yet
+func acquireFileWriter(path string, config *FileConfig) (*fileWriter, error) {
+	key, err := filepath.Abs(path)
+	if err != nil {
+		key = filepath.Clean(path)
+	}
+
+	fileWritersMu.Lock()
+	defer fileWritersMu.Unlock()
+
+	if w, exists := fileWriters[key]; exists {
+		// The new settings apply, e.g. to a channel rebuilt by Manager.Reload
+		w.configs = append(w.configs, config)
+		w.configure(config)
+		return w, nil
+	}
+
+	w := &fileWriter{
+		key:     key,
+		path:    path,
+		configs: []*FileConfig{config},
+	}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	fileWriters[key] = w
+
+	// Apply MaxBackups, MaxAge and Compress to backups left by earlier runs
+	w.configure(config)
+
+	return w, nil
+}
+
+// configure applies the rotation settings of config and processes the
+// existing backups with them
+func (w *fileWriter) configure(config *FileConfig) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.maxSize = int64(config.MaxSize) * megabyte
+	w.maxBackups = config.MaxBackups
+	w.maxAge = time.Duration(config.MaxAge) * 24 * time.Hour
+	w.compress = config.Compress
+	w.signalMill()
+}
+
+// release drops the reference of a FileDriver opened with config, closing
+// the writer with the last one. If the driver was the most recently opened,
+// the settings of the previous one apply again, e.g. when Manager.Reload
+// fails and closes the drivers it built.
+func (w *fileWriter) release(config *FileConfig) error {
+	fileWritersMu.Lock()
+	defer fileWritersMu.Unlock()
+
+	last := len(w.configs) - 1
+	for i := last; i >= 0; i-- {
+		if w.configs[i] == config {
+			w.configs = append(w.configs[:i], w.configs[i+1:]...)
+			if i == last && i > 0 {
+				w.configure(w.configs[i-1])
+			}
+			break
+		}
+	}
+
+	if len(w.configs) > 0 {
+		return nil
 	}
+	delete(fileWriters, w.key)
 
+	// Still under fileWritersMu so a new writer of the path waits for the mill
+	return w.close()
+}
+
+// open creates the log directory and opens the file for appending
+func (w *fileWriter) open() error {
 	// Create directory if it doesn't exist
-	dir := filepath.Dir(path)
+	dir := filepath.Dir(w.path)
 	if err := os.MkdirAll(dir, 0755); err != nil {
-		return nil, fmt.Errorf("failed to create log directory: %w", err)
+		return fmt.Errorf("failed to create log directory: %w", err)
 	}
 
 	// Open file for appending
-	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	file, err := os.OpenFile(w.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open log file: %w", err)
+		return fmt.Errorf("failed to open log file: %w", err)
 	}
 
-	return &FileDriver{
-		file:       file,
-		path:       path,
-		dateFormat: dateFormat,
-	}, nil
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("failed to stat log file: %w", err)
+	}
+
+	w.file = file
+	w.size = info.Size()
+	return nil
 }
 
 // Log writes a log entry to the file
 func (d *FileDriver) Log(entry *Entry) error {
-	d.mu.Lock()
-	defer d.mu.Unlock()
+	if d.closed.Load() {
+		return os.ErrClosed
+	}
 
-	formatted := d.format(entry)
-	_, err := d.file.WriteString(formatted)
+	formatted, err := d.formatter.Format(entry)
+	if err != nil {
+		return fmt.Errorf("failed to format log entry: %w", err)
+	}
+
+	return d.w.write(formatted)
+}
+
+// write appends p to the file, rotating it first if p would push it past MaxSize
+func (w *fileWriter) write(p []byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.maxSize > 0 && w.size > 0 && w.size+int64(len(p)) > w.maxSize {
+		if err := w.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := w.file.Write(p)
+	w.size += int64(n)
 	return err
 }
 
-// format formats the entry for file output (Laravel-style)
-func (d *FileDriver) format(entry *Entry) string {
-	// Format: [2024-01-15 10:30:45] production.INFO: Message {"context":"data"}
-	timestamp := entry.Timestamp.Format(d.dateFormat)
-	channel := entry.Channel
-	if channel == "" {
-		channel = "local"
+// rotate moves the current file to a timestamped backup and opens a new one.
+// The caller must hold w.mu.
+func (w *fileWriter) rotate() error {
+	if err := w.file.Close(); err != nil {
+		return fmt.Errorf("failed to close log file: %w", err)
 	}
 
-	// Build the log line
-	line := fmt.Sprintf("[%s] %s.%s: %s", timestamp, channel, entry.Level.String(), entry.Message)
+	renameErr := os.Rename(w.path, nextBackupName(w.path, time.Now()))
 
-	// Add context if present
-	if len(entry.Context) > 0 {
-		line += "\n"
-		for key, value := range entry.Context {
-			line += fmt.Sprintf("  %s: %v\n", key, formatValue(value))
-		}
+	// Always reopen so the driver keeps working even if the rename failed
+	if err := w.open(); err != nil {
+		return err
+	}
+	if renameErr != nil {
+		return fmt.Errorf("failed to rotate log file: %w", renameErr)
+	}
+
+	w.signalMill()
+	return nil
+}
+
+// signalMill asks the mill goroutine to process backups, starting it on first use.
+// The caller must hold w.mu.
+func (w *fileWriter) signalMill() {
+	if w.maxBackups <= 0 && w.maxAge <= 0 && !w.compress {
+		return
 	}
 
-	// Add exception if present
-	if entry.Exception != nil {
-		line += "\n  Exception:\n"
-		line += fmt.Sprintf("    Class: %s\n", entry.Exception.Class)
-		line += fmt.Sprintf("    Message: %s\n", entry.Exception.Message)
-		if entry.Exception.Code != 0 {
-			line += fmt.Sprintf("    Code: %d\n", entry.Exception.Code)
+	w.millOnce.Do(func() {
+		w.millCh = make(chan struct{}, 1)
+		w.millDone = make(chan struct{})
+		go w.millRun(w.millCh, w.millDone)
+	})
+
+	select {
+	case w.millCh <- struct{}{}:
+	default:
+		// A run is already pending and will see this backup too
+	}
+}
+
+// millRun processes backups until millCh is closed
+func (w *fileWriter) millRun(millCh <-chan struct{}, millDone chan<- struct{}) {
+	defer close(millDone)
+	for range millCh {
+		_ = w.millRunOnce()
+	}
+}
+
+// millRunOnce removes backups beyond MaxBackups or older than MaxAge
+// and compresses the remaining ones when Compress is enabled
+func (w *fileWriter) millRunOnce() error {
+	w.mu.Lock()
+	maxBackups, maxAge, compress := w.maxBackups, w.maxAge, w.compress
+	w.mu.Unlock()
+
+	backups, err := listBackups(w.path)
+	if err != nil {
+		return err
+	}
+
+	var remove, keep []backupFile
+	cutoff := time.Now().Add(-maxAge)
+	for i, b := range backups {
+		switch {
+		case maxBackups > 0 && i >= maxBackups:
+			remove = append(remove, b)
+		case maxAge > 0 && b.timestamp.Before(cutoff):
+			remove = append(remove, b)
+		default:
+			keep = append(keep, b)
 		}
-		if entry.Exception.File != "" {
-			line += fmt.Sprintf("    File: %s:%d\n", entry.Exception.File, entry.Exception.Line)
+	}
+
+	var lastErr error
+	for _, b := range remove {
+		if err := os.Remove(b.path); err != nil && !os.IsNotExist(err) {
+			lastErr = fmt.Errorf("failed to remove old log file: %w", err)
 		}
-		if len(entry.Exception.Trace) > 0 {
-			line += "    Trace:\n"
-			for i, t := range entry.Exception.Trace {
-				line += fmt.Sprintf("      #%d %s\n", i, t)
-				if i >= 10 {
-					line += fmt.Sprintf("      ... and %d more\n", len(entry.Exception.Trace)-10)
-					break
-				}
+	}
+
+	if compress {
+		for _, b := range keep {
+			if b.compressed {
+				continue
+			}
+			if err := compressFile(b.path, b.path+compressSuffix); err != nil {
+				lastErr = err
 			}
 		}
 	}
 
-	line += "\n"
-	return line
+	return lastErr
 }
 
-// formatValue formats a value for log output
-func formatValue(v any) string {
-	switch val := v.(type) {
-	case string:
-		return val
-	case []byte:
-		return string(val)
-	default:
-		return fmt.Sprintf("%v", v)
+// compressFile gzips src into dst and removes src on success
+func compressFile(src, dst string) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("failed to open log file for compression: %w", err)
 	}
+	defer in.Close()
+
+	info, err := in.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to stat log file for compression: %w", err)
+	}
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, info.Mode())
+	if err != nil {
+		return fmt.Errorf("failed to create compressed log file: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			out.Close()
+			os.Remove(dst)
+		}
+	}()
+
+	gz := gzip.NewWriter(out)
+	if _, err = io.Copy(gz, in); err != nil {
+		return fmt.Errorf("failed to compress log file: %w", err)
+	}
+	if err = gz.Close(); err != nil {
+		return fmt.Errorf("failed to compress log file: %w", err)
+	}
+	if err = out.Close(); err != nil {
+		return fmt.Errorf("failed to compress log file: %w", err)
+	}
+
+	in.Close()
+	if err := os.Remove(src); err != nil {
+		return fmt.Errorf("failed to remove compressed log file: %w", err)
+	}
+	return nil
 }
 
-// Close closes the file
+// backupFile describes a rotated log file on disk
+type backupFile struct {
+	path       string
+	timestamp  time.Time
+	compressed bool
+}
+
+// backupName returns the rotated file name for path at time t,
+// e.g. logs/app.log -> logs/app-2024-01-15T10-30-45.000.log
+func backupName(path string, t time.Time) string {
+	dir := filepath.Dir(path)
+	base := filepath.Base(path)
+	ext := filepath.Ext(base)
+	prefix := strings.TrimSuffix(base, ext)
+	return filepath.Join(dir, fmt.Sprintf("%s-%s%s", prefix, t.Format(backupTimeFormat), ext))
+}
+
+// nextBackupName returns a backup name for path that is not already taken,
+// bumping the timestamp when several rotations happen within a millisecond
+func nextBackupName(path string, t time.Time) string {
+	for {
+		name := backupName(path, t)
+		_, err := os.Stat(name)
+		_, gzErr := os.Stat(name + compressSuffix)
+		if os.IsNotExist(err) && os.IsNotExist(gzErr) {
+			return name
+		}
+		t = t.Add(time.Millisecond)
+	}
+}
+
+// listBackups returns the rotated files of path, compressed or not, newest first
+func listBackups(path string) ([]backupFile, error) {
+	dir := filepath.Dir(path)
+	base := filepath.Base(path)
+	ext := filepath.Ext(base)
+	prefix := strings.TrimSuffix(base, ext) + "-"
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read log directory: %w", err)
+	}
+
+	var backups []backupFile
+	for _, f := range files {
+		name := f.Name()
+		compressed := strings.HasSuffix(name, ext+compressSuffix)
+		trimmed := strings.TrimSuffix(name, compressSuffix)
+		if f.IsDir() || !strings.HasPrefix(trimmed, prefix) || !strings.HasSuffix(trimmed, ext) {
+			continue
+		}
+
+		stamp := strings.TrimSuffix(strings.TrimPrefix(trimmed, prefix), ext)
+		t, err := time.ParseInLocation(backupTimeFormat, stamp, time.Local)
+		if err != nil {
+			continue
+		}
+		backups = append(backups, backupFile{
+			path:       filepath.Join(dir, name),
+			timestamp:  t,
+			compressed: compressed,
+		})
+	}
+
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].timestamp.After(backups[j].timestamp)
+	})
+	return backups, nil
+}
+
+// Close closes the file, unless other FileDrivers of the path still use it,
+// and waits for pending compression and cleanup
 func (d *FileDriver) Close() error {
-	d.mu.Lock()
-	defer d.mu.Unlock()
+	if d.closed.Swap(true) {
+		return nil
+	}
+	return d.w.release(d.config)
+}
+
+// close closes the file and stops the mill. The caller must hold fileWritersMu.
+func (w *fileWriter) close() error {
+	// Stop the mill without holding w.mu, which it takes to read the settings
+	w.mu.Lock()
+	millCh, millDone := w.millCh, w.millDone
+	w.millCh = nil
+	w.mu.Unlock()
+
+	if millCh != nil {
+		close(millCh)
+		<-millDone
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
 
-	if d.file != nil {
-		return d.file.Close()
+	if w.file != nil {
+		return w.file.Close()
 	}
 	return nil
 }
 
+// Reopen closes the file and opens it again at the configured path, so writes
+// go to a new file after an external tool such as logrotate renamed the old one
+func (d *FileDriver) Reopen() error {
+	if d.closed.Load() {
+		return os.ErrClosed
+	}
+	return d.w.reopen()
+}
+
+// reopen implements FileDriver.Reopen
+func (w *fileWriter) reopen() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file != nil {
+		if err := w.file.Close(); err != nil {
+			return fmt.Errorf("failed to close log file: %w", err)
+		}
+	}
+	return w.open()
+}
+
 // Name returns the driver name
 func (d *FileDriver) Name() string {
 	return "file"
@@ -137,11 +483,12 @@ func (d *FileDriver) Name() string {
 
 // Flush ensures all data is written to disk
 func (d *FileDriver) Flush() error {
-	d.mu.Lock()
-	defer d.mu.Unlock()
+	w := d.w
+	w.mu.Lock()
+	defer w.mu.Unlock()
 
-	if d.file != nil {
-		return d.file.Sync()
+	if w.file != nil {
+		return w.file.Sync()
 	}
 	return nil
 }
@@ -1,10 +1,13 @@
 package golog
 
 import (
+	"compress/gzip"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestNewFileDriver(t *testing.T) {
@@ -296,3 +299,320 @@ func TestFileDriver_ConcurrentWrites(t *testing.T) {
 		t.Error("Log file should have content")
 	}
 }
+
+func newRotatingDriver(t *testing.T, logPath string, maxBackups int, options ...FileOption) *FileDriver {
+	t.Helper()
+
+	fileConfig := &FileConfig{
+		Path:       logPath,
+		MaxSize:    1,
+		MaxBackups: maxBackups,
+	}
+	for _, opt := range options {
+		opt(fileConfig)
+	}
+
+	driver, err := NewFileDriver(ChannelConfig{
+		Driver:     "file",
+		FileConfig: fileConfig,
+	})
+	if err != nil {
+		t.Fatalf("NewFileDriver failed: %v", err)
+	}
+
+	fd := driver.(*FileDriver)
+	// Shrink the limit so tests don't have to write megabytes
+	fd.w.maxSize = 200
+	return fd
+}
+
+func TestFileDriver_MaxSizeFromConfig(t *testing.T) {
+	driver, err := NewFileDriver(NewFileChannelConfig(filepath.Join(t.TempDir(), "test.log")))
+	if err != nil {
+		t.Fatalf("NewFileDriver failed: %v", err)
+	}
+	defer driver.Close()
+
+	fd := driver.(*FileDriver)
+	if fd.w.maxSize != 100*megabyte {
+		t.Errorf("Expected maxSize %d, got %d", 100*megabyte, fd.w.maxSize)
+	}
+	if fd.w.maxBackups != 3 {
+		t.Errorf("Expected maxBackups 3, got %d", fd.w.maxBackups)
+	}
+}
+
+func TestFileDriver_Rotate(t *testing.T) {
+	tempDir := t.TempDir()
+	logPath := filepath.Join(tempDir, "app.log")
+
+	driver := newRotatingDriver(t, logPath, 0)
+
+	for i := 0; i < 5; i++ {
+		if err := driver.Log(NewEntry(InfoLevel, strings.Repeat("x", 100))); err != nil {
+			t.Fatalf("Log failed: %v", err)
+		}
+	}
+	driver.Close()
+
+	backups, err := listBackups(logPath)
+	if err != nil {
+		t.Fatalf("listBackups failed: %v", err)
+	}
+	if len(backups) != 4 {
+		t.Errorf("Expected 4 backups, got %d", len(backups))
+	}
+
+	info, err := os.Stat(logPath)
+	if err != nil {
+		t.Fatalf("Stat failed: %v", err)
+	}
+	if info.Size() > 200 {
+		t.Errorf("Expected current file to stay under max size, got %d bytes", info.Size())
+	}
+
+	for _, b := range backups {
+		if !strings.HasPrefix(filepath.Base(b.path), "app-") || filepath.Ext(b.path) != ".log" {
+			t.Errorf("Unexpected backup name %q", b.path)
+		}
+	}
+}
+
+func TestFileDriver_RotateMaxBackups(t *testing.T) {
+	tempDir := t.TempDir()
+	logPath := filepath.Join(tempDir, "app.log")
+
+	driver := newRotatingDriver(t, logPath, 2)
+
+	for i := 0; i < 6; i++ {
+		if err := driver.Log(NewEntry(InfoLevel, strings.Repeat("x", 100))); err != nil {
+			t.Fatalf("Log failed: %v", err)
+		}
+	}
+	driver.Close()
+
+	backups, err := listBackups(logPath)
+	if err != nil {
+		t.Fatalf("listBackups failed: %v", err)
+	}
+	if len(backups) != 2 {
+		t.Errorf("Expected 2 backups, got %d", len(backups))
+	}
+}
+
+func TestFileDriver_RotateExistingFile(t *testing.T) {
+	tempDir := t.TempDir()
+	logPath := filepath.Join(tempDir, "app.log")
+
+	if err := os.WriteFile(logPath, []byte(strings.Repeat("y", 190)), 0644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+
+	driver := newRotatingDriver(t, logPath, 0)
+	if err := driver.Log(NewEntry(InfoLevel, "message")); err != nil {
+		t.Fatalf("Log failed: %v", err)
+	}
+	driver.Close()
+
+	backups, _ := listBackups(logPath)
+	if len(backups) != 1 {
+		t.Errorf("Expected existing file to be rotated, got %d backups", len(backups))
+	}
+}
+
+func TestFileDriver_SharedPath(t *testing.T) {
+	tempDir := t.TempDir()
+	logPath := filepath.Join(tempDir, "app.log")
+
+	first := newRotatingDriver(t, logPath, 0)
+	second, err := NewFileDriver(NewFileChannelConfig(filepath.Join(tempDir, ".", "app.log"),
+		WithFileMaxBackups(0), WithFileCompress(false)))
+	if err != nil {
+		t.Fatalf("NewFileDriver failed: %v", err)
+	}
+	if second.(*FileDriver).w != first.w {
+		t.Fatal("Expected drivers of the same path to share the file")
+	}
+
+	// The settings of the most recently opened driver apply
+	if first.w.maxSize != 100*megabyte {
+		t.Errorf("Expected the second driver's max size, got %d", first.w.maxSize)
+	}
+	first.w.maxSize = 200
+
+	for i := 0; i < 3; i++ {
+		first.Log(NewEntry(InfoLevel, strings.Repeat("x", 100)))
+		second.Log(NewEntry(InfoLevel, strings.Repeat("y", 100)))
+	}
+
+	// Closing one driver leaves the file open for the other
+	first.Close()
+	if err := second.Log(NewEntry(InfoLevel, "still open")); err != nil {
+		t.Errorf("Expected shared file to stay open, got %v", err)
+	}
+	second.Close()
+
+	backups, _ := listBackups(logPath)
+	if len(backups) != 5 {
+		t.Errorf("Expected 5 backups from the shared size count, got %d", len(backups))
+	}
+	for _, b := range backups {
+		if info, err := os.Stat(b.path); err != nil || info.Size() > 200 {
+			t.Errorf("Expected backup %q to stay under max size, got %v", b.path, info.Size())
+		}
+	}
+}
+
+func TestFileDriver_MillAtStartup(t *testing.T) {
+	logPath := filepath.Join(t.TempDir(), "app.log")
+
+	for i := 1; i <= 4; i++ {
+		old := backupName(logPath, time.Now().Add(-time.Duration(i)*time.Hour))
+		if err := os.WriteFile(old, []byte("old"), 0644); err != nil {
+			t.Fatalf("WriteFile failed: %v", err)
+		}
+	}
+
+	driver, err := NewFileDriver(NewFileChannelConfig(logPath, WithFileMaxBackups(2), WithFileCompress(false)))
+	if err != nil {
+		t.Fatalf("NewFileDriver failed: %v", err)
+	}
+	driver.Close()
+
+	backups, _ := listBackups(logPath)
+	if len(backups) != 2 {
+		t.Errorf("Expected existing backups to be pruned to 2, got %d", len(backups))
+	}
+}
+
+func TestBackupName(t *testing.T) {
+	ts := time.Date(2024, 1, 15, 10, 30, 45, 0, time.Local)
+	got := backupName(filepath.Join("logs", "app.log"), ts)
+	want := filepath.Join("logs", "app-2024-01-15T10-30-45.000.log")
+	if got != want {
+		t.Errorf("backupName() = %q, want %q", got, want)
+	}
+}
+
+func TestFileDriver_RotateMaxAge(t *testing.T) {
+	tempDir := t.TempDir()
+	logPath := filepath.Join(tempDir, "app.log")
+
+	old := backupName(logPath, time.Now().Add(-72*time.Hour))
+	if err := os.WriteFile(old, []byte("old"), 0644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+
+	driver := newRotatingDriver(t, logPath, 0, WithFileMaxAge(1))
+	for i := 0; i < 3; i++ {
+		if err := driver.Log(NewEntry(InfoLevel, strings.Repeat("x", 100))); err != nil {
+			t.Fatalf("Log failed: %v", err)
+		}
+	}
+	driver.Close()
+
+	if _, err := os.Stat(old); !os.IsNotExist(err) {
+		t.Error("Expected backup older than MaxAge to be removed")
+	}
+
+	backups, _ := listBackups(logPath)
+	if len(backups) != 2 {
+		t.Errorf("Expected 2 recent backups to be kept, got %d", len(backups))
+	}
+}
+
+func TestFileDriver_RotateCompress(t *testing.T) {
+	tempDir := t.TempDir()
+	logPath := filepath.Join(tempDir, "app.log")
+
+	driver := newRotatingDriver(t, logPath, 0, WithFileCompress(true))
+	for i := 0; i < 3; i++ {
+		if err := driver.Log(NewEntry(InfoLevel, strings.Repeat("x", 100))); err != nil {
+			t.Fatalf("Log failed: %v", err)
+		}
+	}
+	driver.Close()
+
+	backups, err := listBackups(logPath)
+	if err != nil {
+		t.Fatalf("listBackups failed: %v", err)
+	}
+	if len(backups) != 2 {
+		t.Fatalf("Expected 2 backups, got %d", len(backups))
+	}
+
+	for _, b := range backups {
+		if !b.compressed || !strings.HasSuffix(b.path, ".log.gz") {
+			t.Errorf("Expected compressed backup, got %q", b.path)
+			continue
+		}
+
+		f, err := os.Open(b.path)
+		if err != nil {
+			t.Fatalf("Open failed: %v", err)
+		}
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			t.Fatalf("gzip.NewReader failed: %v", err)
+		}
+		content, _ := io.ReadAll(gz)
+		f.Close()
+
+		if !strings.Contains(string(content), "xxxx") {
+			t.Errorf("Compressed backup %q is missing log content", b.path)
+		}
+	}
+}
+
+func TestFileDriver_RotateCompressMaxBackups(t *testing.T) {
+	tempDir := t.TempDir()
+	logPath := filepath.Join(tempDir, "app.log")
+
+	driver := newRotatingDriver(t, logPath, 1, WithFileCompress(true))
+	for i := 0; i < 4; i++ {
+		if err := driver.Log(NewEntry(InfoLevel, strings.Repeat("x", 100))); err != nil {
+			t.Fatalf("Log failed: %v", err)
+		}
+	}
+	driver.Close()
+
+	backups, _ := listBackups(logPath)
+	if len(backups) != 1 {
+		t.Errorf("Expected 1 backup, got %d", len(backups))
+	}
+}
+
+func TestFileDriver_Reopen(t *testing.T) {
+	tempDir := t.TempDir()
+	logPath := filepath.Join(tempDir, "app.log")
+	rotated := filepath.Join(tempDir, "app.log.1")
+
+	driver, err := NewFileDriver(NewFileChannelConfig(logPath))
+	if err != nil {
+		t.Fatalf("NewFileDriver failed: %v", err)
+	}
+	defer driver.Close()
+
+	driver.Log(NewEntry(InfoLevel, "before rotation"))
+
+	// Simulate logrotate with `create`: move the file away
+	if err := os.Rename(logPath, rotated); err != nil {
+		t.Fatal(err)
+	}
+	driver.Log(NewEntry(InfoLevel, "still old inode"))
+
+	if err := driver.(Reopener).Reopen(); err != nil {
+		t.Fatalf("Reopen failed: %v", err)
+	}
+	driver.Log(NewEntry(InfoLevel, "after reopen"))
+
+	old, _ := os.ReadFile(rotated)
+	if !strings.Contains(string(old), "still old inode") || strings.Contains(string(old), "after reopen") {
+		t.Errorf("Unexpected rotated content %q", old)
+	}
+
+	current, _ := os.ReadFile(logPath)
+	if !strings.Contains(string(current), "after reopen") || strings.Contains(string(current), "before rotation") {
+		t.Errorf("Unexpected current content %q", current)
+	}
+}
@@ -0,0 +1,268 @@
+package golog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Formatter converts a log entry into the bytes written by a driver
+type Formatter interface {
+	// Format returns the serialized entry, including any trailing newline
+	Format(entry *Entry) ([]byte, error)
+}
+
+// FormatterFactory creates a formatter from channel configuration
+type FormatterFactory func(config ChannelConfig) (Formatter, error)
+
+// Built-in formatter factories
+var formatterFactories = map[string]FormatterFactory{
+	"line":   newLineFormatterFromConfig,
+	"json":   newJSONFormatterFromConfig,
+	"logfmt": newLogfmtFormatterFromConfig,
+}
+
+// RegisterFormatter registers a custom formatter factory
+func RegisterFormatter(name string, factory FormatterFactory) {
+	formatterFactories[name] = factory
+}
+
+// GetFormatterFactory returns the factory for a formatter name
+func GetFormatterFactory(name string) (FormatterFactory, bool) {
+	factory, ok := formatterFactories[name]
+	return factory, ok
+}
+
+// NewFormatter creates the formatter selected by config.Formatter,
+// falling back to the Laravel-style line formatter
+func NewFormatter(config ChannelConfig) (Formatter, error) {
+	name := config.Formatter
+	if name == "" {
+		name = "line"
+	}
+
+	factory, exists := GetFormatterFactory(name)
+	if !exists {
+		return nil, fmt.Errorf("formatter [%s] is not supported", name)
+	}
+
+	return factory(config)
+}
+
+// LineFormatter formats entries in the Laravel log layout:
+//
+//	[2024-01-15 10:30:45] production.INFO: Message
+//	  key: value
+//
+// When Template is set, entries are rendered from that Monolog-style template instead.
+type LineFormatter struct {
+	// DateFormat is the timestamp layout (default: "2006-01-02 15:04:05")
+	DateFormat string
+
+	// Template is an optional Monolog LineFormatter template, see MonologLineFormat
+	Template string
+
+	// AllowInlineLineBreaks keeps line breaks in messages and context (template only)
+	AllowInlineLineBreaks bool
+
+	// IgnoreEmptyContextAndExtra drops %context% and %extra% when empty instead
+	// of rendering "[]" (template only)
+	IgnoreEmptyContextAndExtra bool
+}
+
+// NewLineFormatter creates a line formatter with the given date format
+func NewLineFormatter(dateFormat string) *LineFormatter {
+	if dateFormat == "" {
+		dateFormat = "2006-01-02 15:04:05"
+	}
+	return &LineFormatter{DateFormat: dateFormat}
+}
+
+// newLineFormatterFromConfig creates a line formatter from channel configuration
+func newLineFormatterFromConfig(config ChannelConfig) (Formatter, error) {
+	var dateFormat string
+	if config.FileConfig != nil {
+		dateFormat = config.FileConfig.DateFormat
+	}
+
+	f := NewLineFormatter(dateFormat)
+	if config.FormatterConfig != nil {
+		f.Template = config.FormatterConfig.LineFormat
+		f.AllowInlineLineBreaks = config.FormatterConfig.AllowInlineLineBreaks
+		f.IgnoreEmptyContextAndExtra = config.FormatterConfig.IgnoreEmptyContextAndExtra
+	}
+	return f, nil
+}
+
+// Format formats the entry for file output (Laravel-style)
+func (f *LineFormatter) Format(entry *Entry) ([]byte, error) {
+	if f.Template != "" {
+		return f.formatTemplate(entry)
+	}
+
+	dateFormat := f.DateFormat
+	if dateFormat == "" {
+		dateFormat = "2006-01-02 15:04:05"
+	}
+
+	timestamp := entry.Timestamp.Format(dateFormat)
+	channel := entry.Channel
+	if channel == "" {
+		channel = "local"
+	}
+
+	var b strings.Builder
+
+	// Build the log line
+	fmt.Fprintf(&b, "[%s] %s.%s: %s", timestamp, channel, entry.Level.String(), entry.Message)
+
+	// Add context if present
+	if len(entry.Context) > 0 {
+		b.WriteString("\n")
+		for key, value := range entry.Context {
+			fmt.Fprintf(&b, "  %s: %v\n", key, formatValue(value))
+		}
+	}
+
+	// Add caller if captured
+	if entry.Caller != nil {
+		fmt.Fprintf(&b, "\n  Caller: %s\n", entry.Caller)
+	}
+
+	// Add extra data from processors if present
+	if len(entry.Extra) > 0 {
+		b.WriteString("\n  Extra:\n")
+		for key, value := range entry.Extra {
+			fmt.Fprintf(&b, "    %s: %v\n", key, formatValue(value))
+		}
+	}
+
+	// Add exception if present
+	if entry.Exception != nil {
+		b.WriteString("\n  Exception:\n")
+		fmt.Fprintf(&b, "    Class: %s\n", entry.Exception.Class)
+		fmt.Fprintf(&b, "    Message: %s\n", entry.Exception.Message)
+		if entry.Exception.Code != 0 {
+			fmt.Fprintf(&b, "    Code: %d\n", entry.Exception.Code)
+		}
+		if entry.Exception.File != "" {
+			fmt.Fprintf(&b, "    File: %s:%d\n", entry.Exception.File, entry.Exception.Line)
+		}
+		if len(entry.Exception.Trace) > 0 {
+			b.WriteString("    Trace:\n")
+			for i, t := range entry.Exception.Trace {
+				fmt.Fprintf(&b, "      #%d %s\n", i, t)
+				if i >= 10 {
+					fmt.Fprintf(&b, "      ... and %d more\n", len(entry.Exception.Trace)-10)
+					break
+				}
+			}
+		}
+	}
+
+	b.WriteString("\n")
+	return []byte(b.String()), nil
+}
+
+// formatValue formats a value for log output
+func formatValue(v any) string {
+	switch val := v.(type) {
+	case string:
+		return val
+	case []byte:
+		return string(val)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// JSONFormatter formats entries as JSON Lines: one compact JSON object per line,
+// suitable for log shippers such as Vector or Fluent Bit
+type JSONFormatter struct {
+	// AppName is included as "app_name" when set
+	AppName string
+}
+
+// NewJSONFormatter creates a JSON Lines formatter
+func NewJSONFormatter(appName string) *JSONFormatter {
+	return &JSONFormatter{AppName: appName}
+}
+
+// newJSONFormatterFromConfig creates a JSON Lines formatter from channel configuration
+func newJSONFormatterFromConfig(config ChannelConfig) (Formatter, error) {
+	return NewJSONFormatter(config.AppName), nil
+}
+
+// jsonLine is the serialized shape of an entry in JSON Lines output
+type jsonLine struct {
+	Timestamp string         `json:"timestamp"`
+	Level     string         `json:"level"`
+	Message   string         `json:"message"`
+	Channel   string         `json:"channel,omitempty"`
+	AppName   string         `json:"app_name,omitempty"`
+	Context   map[string]any `json:"context,omitempty"`
+	Extra     map[string]any `json:"extra,omitempty"`
+	Caller    *CallerInfo    `json:"caller,omitempty"`
+	Exception *ExceptionInfo `json:"exception,omitempty"`
+}
+
+// Format serializes the entry on a single line
+func (f *JSONFormatter) Format(entry *Entry) ([]byte, error) {
+	line := jsonLine{
+		Timestamp: entry.Timestamp.Format(time.RFC3339Nano),
+		Level:     entry.Level.String(),
+		Message:   entry.Message,
+		Channel:   entry.Channel,
+		AppName:   f.AppName,
+		Context:   jsonContext(entry.Context, false),
+		Extra:     jsonContext(entry.Extra, false),
+		Caller:    entry.Caller,
+		Exception: entry.Exception,
+	}
+
+	b, err := encodeJSONLine(line)
+	if err != nil {
+		// Retry with values that cannot be encoded replaced by their %v form
+		line.Context = jsonContext(entry.Context, true)
+		line.Extra = jsonContext(entry.Extra, true)
+		return encodeJSONLine(line)
+	}
+	return b, nil
+}
+
+// encodeJSONLine encodes v followed by a newline without HTML escaping
+func encodeJSONLine(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// jsonContext prepares context values for JSON encoding. Errors become their
+// message; with sanitize, values that cannot be encoded become their %v form.
+func jsonContext(ctx map[string]any, sanitize bool) map[string]any {
+	if len(ctx) == 0 {
+		return nil
+	}
+
+	result := make(map[string]any, len(ctx))
+	for k, v := range ctx {
+		if err, ok := v.(error); ok {
+			result[k] = err.Error()
+			continue
+		}
+		if sanitize {
+			if _, err := json.Marshal(v); err != nil {
+				result[k] = fmt.Sprintf("%v", v)
+				continue
+			}
+		}
+		result[k] = v
+	}
+	return result
+}
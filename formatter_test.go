@@ -0,0 +1,231 @@
+package golog
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewFormatter_Default(t *testing.T) {
+	formatter, err := NewFormatter(ChannelConfig{})
+	if err != nil {
+		t.Fatalf("NewFormatter failed: %v", err)
+	}
+
+	if _, ok := formatter.(*LineFormatter); !ok {
+		t.Errorf("Expected *LineFormatter by default, got %T", formatter)
+	}
+}
+
+func TestNewFormatter_Unknown(t *testing.T) {
+	_, err := NewFormatter(ChannelConfig{Formatter: "unknown"})
+	if err == nil {
+		t.Error("Expected error for unknown formatter")
+	}
+}
+
+func TestNewFormatter_DateFormatFromFileConfig(t *testing.T) {
+	formatter, err := NewFormatter(NewFileChannelConfig("logs/app.log", WithFileDateFormat("2006/01/02")))
+	if err != nil {
+		t.Fatalf("NewFormatter failed: %v", err)
+	}
+
+	lf := formatter.(*LineFormatter)
+	if lf.DateFormat != "2006/01/02" {
+		t.Errorf("Expected date format '2006/01/02', got %q", lf.DateFormat)
+	}
+}
+
+func TestLineFormatter_Format(t *testing.T) {
+	entry := NewEntry(InfoLevel, "User logged in")
+	entry.Timestamp = time.Date(2024, 1, 15, 10, 30, 45, 0, time.UTC)
+	entry.SetChannel("production")
+	entry.With("user_id", 123)
+
+	out, err := NewLineFormatter("").Format(entry)
+	if err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+
+	want := "[2024-01-15 10:30:45] production.INFO: User logged in\n  user_id: 123\n\n"
+	if string(out) != want {
+		t.Errorf("Format() = %q, want %q", out, want)
+	}
+}
+
+func TestLineFormatter_DefaultChannel(t *testing.T) {
+	entry := NewEntry(DebugLevel, "message")
+
+	out, _ := NewLineFormatter("").Format(entry)
+	if !strings.Contains(string(out), "local.DEBUG: message") {
+		t.Errorf("Expected 'local' channel, got %q", out)
+	}
+}
+
+func TestLineFormatter_Exception(t *testing.T) {
+	trace := make([]string, 15)
+	for i := range trace {
+		trace[i] = "/app/main.go:10"
+	}
+
+	entry := NewEntry(ErrorLevel, "failed")
+	entry.WithException("DatabaseError", "timeout", 500, "/app/db.go", 42, trace)
+
+	out, _ := NewLineFormatter("").Format(entry)
+	for _, want := range []string{"Class: DatabaseError", "Code: 500", "File: /app/db.go:42", "... and 5 more"} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("Expected %q in output %q", want, out)
+		}
+	}
+}
+
+type upperFormatter struct{}
+
+func (upperFormatter) Format(entry *Entry) ([]byte, error) {
+	return []byte(strings.ToUpper(entry.Message) + "\n"), nil
+}
+
+func TestRegisterFormatter(t *testing.T) {
+	RegisterFormatter("upper", func(config ChannelConfig) (Formatter, error) {
+		return upperFormatter{}, nil
+	})
+	defer delete(formatterFactories, "upper")
+
+	logPath := filepath.Join(t.TempDir(), "test.log")
+	config := NewFileChannelConfig(logPath)
+	config.Formatter = "upper"
+
+	driver, err := NewFileDriver(config)
+	if err != nil {
+		t.Fatalf("NewFileDriver failed: %v", err)
+	}
+
+	driver.Log(NewEntry(InfoLevel, "shout"))
+	driver.Close()
+
+	content, _ := os.ReadFile(logPath)
+	if string(content) != "SHOUT\n" {
+		t.Errorf("Expected custom formatter output, got %q", content)
+	}
+}
+
+func TestNewFileDriver_UnknownFormatter(t *testing.T) {
+	config := NewFileChannelConfig(filepath.Join(t.TempDir(), "test.log"))
+	config.Formatter = "unknown"
+
+	if _, err := NewFileDriver(config); err == nil {
+		t.Error("Expected error for unknown formatter")
+	}
+}
+
+func TestJSONFormatter_Format(t *testing.T) {
+	entry := NewEntry(ErrorLevel, "payment <failed>")
+	entry.Timestamp = time.Date(2024, 1, 15, 10, 30, 45, 123456789, time.UTC)
+	entry.SetChannel("payments")
+	entry.With("order_id", 42)
+	entry.With("error", errors.New("card declined"))
+	entry.WithException("PaymentError", "declined", 402, "/app/pay.go", 7, nil)
+
+	out, err := NewJSONFormatter("Shop").Format(entry)
+	if err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+
+	if !strings.HasSuffix(string(out), "}\n") || strings.Count(string(out), "\n") != 1 {
+		t.Errorf("Expected a single line terminated by newline, got %q", out)
+	}
+
+	var decoded map[string]any
+	if err := json.Unmarshal(out, &decoded); err != nil {
+		t.Fatalf("Output is not valid JSON: %v", err)
+	}
+
+	checks := map[string]any{
+		"timestamp": "2024-01-15T10:30:45.123456789Z",
+		"level":     "ERROR",
+		"message":   "payment <failed>",
+		"channel":   "payments",
+		"app_name":  "Shop",
+	}
+	for key, want := range checks {
+		if decoded[key] != want {
+			t.Errorf("%s = %v, want %v", key, decoded[key], want)
+		}
+	}
+
+	ctx, _ := decoded["context"].(map[string]any)
+	if ctx["order_id"] != float64(42) {
+		t.Errorf("Expected order_id 42 in context, got %v", ctx["order_id"])
+	}
+	if ctx["error"] != "card declined" {
+		t.Errorf("Expected error message in context, got %v", ctx["error"])
+	}
+
+	exception, _ := decoded["exception"].(map[string]any)
+	if exception["class"] != "PaymentError" {
+		t.Errorf("Expected exception class, got %v", exception["class"])
+	}
+}
+
+func TestJSONFormatter_UnencodableContext(t *testing.T) {
+	entry := NewEntry(InfoLevel, "message")
+	entry.With("callback", func() {})
+	entry.With("user_id", 1)
+
+	out, err := NewJSONFormatter("").Format(entry)
+	if err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+
+	var decoded map[string]any
+	if err := json.Unmarshal(out, &decoded); err != nil {
+		t.Fatalf("Output is not valid JSON: %v", err)
+	}
+
+	if _, ok := decoded["app_name"]; ok {
+		t.Error("Expected app_name to be omitted when empty")
+	}
+
+	ctx, _ := decoded["context"].(map[string]any)
+	if _, ok := ctx["callback"].(string); !ok {
+		t.Errorf("Expected unencodable value to fall back to a string, got %v", ctx["callback"])
+	}
+}
+
+func TestManager_JSONFormatterAppName(t *testing.T) {
+	logPath := filepath.Join(t.TempDir(), "app.jsonl")
+	fileConfig := NewFileChannelConfig(logPath)
+	fileConfig.Formatter = "json"
+
+	manager, _ := NewManager(&Config{
+		Default:  "file",
+		AppName:  "MyApp",
+		Channels: map[string]ChannelConfig{"file": fileConfig},
+	})
+
+	logger, err := manager.Channel("file")
+	if err != nil {
+		t.Fatalf("Channel failed: %v", err)
+	}
+	logger.Info("first")
+	logger.Info("second")
+	manager.Close()
+
+	content, _ := os.ReadFile(logPath)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines, got %d: %q", len(lines), content)
+	}
+
+	var decoded map[string]any
+	if err := json.Unmarshal([]byte(lines[0]), &decoded); err != nil {
+		t.Fatalf("Line is not valid JSON: %v", err)
+	}
+	if decoded["app_name"] != "MyApp" || decoded["channel"] != "file" {
+		t.Errorf("Unexpected line %q", lines[0])
+	}
+}
@@ -20,7 +20,10 @@
 //	})
 package golog
 
-import "sync"
+import (
+	"context"
+	"sync"
+)
 
 var (
 	defaultManager *Manager
@@ -161,3 +164,182 @@ func Emergency(message string, context ...map[string]any) {
 	}
 }
 
+// --- Printf-style and key-value logging functions using default channel ---
+
+// Debugf logs a debug message formatted with fmt.Sprintf to the default channel
+func Debugf(format string, args ...any) {
+	if log, err := Default(); err == nil {
+		log.Debugf(format, args...)
+	}
+}
+
+// Infof logs an info message formatted with fmt.Sprintf to the default channel
+func Infof(format string, args ...any) {
+	if log, err := Default(); err == nil {
+		log.Infof(format, args...)
+	}
+}
+
+// Noticef logs a notice message formatted with fmt.Sprintf to the default channel
+func Noticef(format string, args ...any) {
+	if log, err := Default(); err == nil {
+		log.Noticef(format, args...)
+	}
+}
+
+// Warningf logs a warning message formatted with fmt.Sprintf to the default channel
+func Warningf(format string, args ...any) {
+	if log, err := Default(); err == nil {
+		log.Warningf(format, args...)
+	}
+}
+
+// Errorf logs an error message formatted with fmt.Sprintf to the default channel
+func Errorf(format string, args ...any) {
+	if log, err := Default(); err == nil {
+		log.Errorf(format, args...)
+	}
+}
+
+// Criticalf logs a critical message formatted with fmt.Sprintf to the default channel
+func Criticalf(format string, args ...any) {
+	if log, err := Default(); err == nil {
+		log.Criticalf(format, args...)
+	}
+}
+
+// Alertf logs an alert message formatted with fmt.Sprintf to the default channel
+func Alertf(format string, args ...any) {
+	if log, err := Default(); err == nil {
+		log.Alertf(format, args...)
+	}
+}
+
+// Emergencyf logs an emergency message formatted with fmt.Sprintf to the default channel
+func Emergencyf(format string, args ...any) {
+	if log, err := Default(); err == nil {
+		log.Emergencyf(format, args...)
+	}
+}
+
+// Debugw logs a debug message with alternating key-value context to the default channel
+func Debugw(message string, keysAndValues ...any) {
+	if log, err := Default(); err == nil {
+		log.Debugw(message, keysAndValues...)
+	}
+}
+
+// Infow logs an info message with alternating key-value context to the default channel
+func Infow(message string, keysAndValues ...any) {
+	if log, err := Default(); err == nil {
+		log.Infow(message, keysAndValues...)
+	}
+}
+
+// Noticew logs a notice message with alternating key-value context to the default channel
+func Noticew(message string, keysAndValues ...any) {
+	if log, err := Default(); err == nil {
+		log.Noticew(message, keysAndValues...)
+	}
+}
+
+// Warningw logs a warning message with alternating key-value context to the default channel
+func Warningw(message string, keysAndValues ...any) {
+	if log, err := Default(); err == nil {
+		log.Warningw(message, keysAndValues...)
+	}
+}
+
+// Errorw logs an error message with alternating key-value context to the default channel
+func Errorw(message string, keysAndValues ...any) {
+	if log, err := Default(); err == nil {
+		log.Errorw(message, keysAndValues...)
+	}
+}
+
+// Criticalw logs a critical message with alternating key-value context to the default channel
+func Criticalw(message string, keysAndValues ...any) {
+	if log, err := Default(); err == nil {
+		log.Criticalw(message, keysAndValues...)
+	}
+}
+
+// Alertw logs an alert message with alternating key-value context to the default channel
+func Alertw(message string, keysAndValues ...any) {
+	if log, err := Default(); err == nil {
+		log.Alertw(message, keysAndValues...)
+	}
+}
+
+// Emergencyw logs an emergency message with alternating key-value context to the default channel
+func Emergencyw(message string, keysAndValues ...any) {
+	if log, err := Default(); err == nil {
+		log.Emergencyw(message, keysAndValues...)
+	}
+}
+
+// --- Context-aware logging functions ---
+
+// loggerFromContext returns the logger stored in ctx, or the default channel logger
+func loggerFromContext(ctx context.Context) (*Logger, error) {
+	if log, ok := FromContext(ctx); ok {
+		return log, nil
+	}
+	return Default()
+}
+
+// DebugContext logs a debug message to the logger in ctx (or the default channel)
+func DebugContext(ctx context.Context, message string, fields ...map[string]any) {
+	if log, err := loggerFromContext(ctx); err == nil {
+		log.DebugContext(ctx, message, fields...)
+	}
+}
+
+// InfoContext logs an info message to the logger in ctx (or the default channel)
+func InfoContext(ctx context.Context, message string, fields ...map[string]any) {
+	if log, err := loggerFromContext(ctx); err == nil {
+		log.InfoContext(ctx, message, fields...)
+	}
+}
+
+// NoticeContext logs a notice message to the logger in ctx (or the default channel)
+func NoticeContext(ctx context.Context, message string, fields ...map[string]any) {
+	if log, err := loggerFromContext(ctx); err == nil {
+		log.NoticeContext(ctx, message, fields...)
+	}
+}
+
+// WarningContext logs a warning message to the logger in ctx (or the default channel)
+func WarningContext(ctx context.Context, message string, fields ...map[string]any) {
+	if log, err := loggerFromContext(ctx); err == nil {
+		log.WarningContext(ctx, message, fields...)
+	}
+}
+
+// ErrorContext logs an error message to the logger in ctx (or the default channel)
+func ErrorContext(ctx context.Context, message string, fields ...map[string]any) {
+	if log, err := loggerFromContext(ctx); err == nil {
+		log.ErrorContext(ctx, message, fields...)
+	}
+}
+
+// CriticalContext logs a critical message to the logger in ctx (or the default channel)
+func CriticalContext(ctx context.Context, message string, fields ...map[string]any) {
+	if log, err := loggerFromContext(ctx); err == nil {
+		log.CriticalContext(ctx, message, fields...)
+	}
+}
+
+// AlertContext logs an alert message to the logger in ctx (or the default channel)
+func AlertContext(ctx context.Context, message string, fields ...map[string]any) {
+	if log, err := loggerFromContext(ctx); err == nil {
+		log.AlertContext(ctx, message, fields...)
+	}
+}
+
+// EmergencyContext logs an emergency message to the logger in ctx (or the default channel)
+func EmergencyContext(ctx context.Context, message string, fields ...map[string]any) {
+	if log, err := loggerFromContext(ctx); err == nil {
+		log.EmergencyContext(ctx, message, fields...)
+	}
+}
@@ -443,3 +443,43 @@ func (e *testError) Error() string {
 	return e.message
 }
 
+func TestConvenienceLogging_FormattedAndKeyValue(t *testing.T) {
+	resetGlobalState()
+	defer resetGlobalState()
+
+	logPath := filepath.Join(t.TempDir(), "test.log")
+	Init(&Config{
+		Default: "file",
+		Channels: map[string]ChannelConfig{
+			"file": NewFileChannelConfig(logPath),
+		},
+	})
+	defer Close()
+
+	Debugf("debugf %d", 1)
+	Infof("infof %d", 1)
+	Noticef("noticef %d", 1)
+	Warningf("warningf %d", 1)
+	Errorf("errorf %d", 1)
+	Criticalf("criticalf %d", 1)
+	Alertf("alertf %d", 1)
+	Emergencyf("emergencyf %d", 1)
+
+	Debugw("debugw", "k", 1)
+	Infow("infow", "user_id", 123)
+	Noticew("noticew", "k", 1)
+	Warningw("warningw", "k", 1)
+	Errorw("errorw", "k", 1)
+	Criticalw("criticalw", "k", 1)
+	Alertw("alertw", "k", 1)
+	Emergencyw("emergencyw", "k", 1)
+
+	content, _ := os.ReadFile(logPath)
+	logContent := string(content)
+
+	for _, msg := range []string{"debugf 1", "emergencyf 1", "infow", "user_id: 123", "emergencyw"} {
+		if !strings.Contains(logContent, msg) {
+			t.Errorf("Expected %q in log", msg)
+		}
+	}
+}
@@ -1,6 +1,10 @@
 package golog
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
 
 // Level represents the severity of a log entry
 type Level int
@@ -120,26 +124,71 @@ func (l Level) SlackColor() string {
 	}
 }
 
-// ParseLevel parses a string into a Level
+// SyslogSeverity returns the RFC 5424 severity for the level
+// (0 = Emergency ... 7 = Debug)
+func (l Level) SyslogSeverity() int {
+	if l < DebugLevel || l > EmergencyLevel {
+		return 6 // Informational
+	}
+	return int(EmergencyLevel - l)
+}
+
+// ParseLevel parses a string into a Level, falling back to InfoLevel
 func ParseLevel(s string) Level {
+	level, err := parseLevel(s)
+	if err != nil {
+		return InfoLevel
+	}
+	return level
+}
+
+// parseLevel parses a string into a Level, reporting unknown names
+func parseLevel(s string) (Level, error) {
 	switch strings.ToUpper(strings.TrimSpace(s)) {
 	case "DEBUG":
-		return DebugLevel
+		return DebugLevel, nil
 	case "INFO":
-		return InfoLevel
+		return InfoLevel, nil
 	case "NOTICE":
-		return NoticeLevel
+		return NoticeLevel, nil
 	case "WARNING", "WARN":
-		return WarningLevel
+		return WarningLevel, nil
 	case "ERROR", "ERR":
-		return ErrorLevel
+		return ErrorLevel, nil
 	case "CRITICAL", "CRIT":
-		return CriticalLevel
+		return CriticalLevel, nil
 	case "ALERT":
-		return AlertLevel
+		return AlertLevel, nil
 	case "EMERGENCY", "EMERG":
-		return EmergencyLevel
+		return EmergencyLevel, nil
 	default:
-		return InfoLevel
+		return InfoLevel, fmt.Errorf("level [%s] is not valid", s)
 	}
 }
+
+// AtomicLevel is a minimum level that can be read and changed concurrently
+type AtomicLevel struct {
+	v atomic.Int32
+}
+
+// NewAtomicLevel creates an atomic level set to level
+func NewAtomicLevel(level Level) *AtomicLevel {
+	a := &AtomicLevel{}
+	a.SetLevel(level)
+	return a
+}
+
+// Level returns the current level
+func (a *AtomicLevel) Level() Level {
+	return Level(a.v.Load())
+}
+
+// SetLevel changes the level
+func (a *AtomicLevel) SetLevel(level Level) {
+	a.v.Store(int32(level))
+}
+
+// Enabled reports whether entries at level pass the minimum
+func (a *AtomicLevel) Enabled(level Level) bool {
+	return level >= a.Level()
+}
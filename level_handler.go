@@ -0,0 +1,107 @@
+package golog
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// levelPayload is the JSON body read and written by LevelHandler
+type levelPayload struct {
+	Channel string `json:"channel,omitempty"`
+	Level   string `json:"level,omitempty"`
+}
+
+// levelsPayload lists the levels of all channels
+type levelsPayload struct {
+	Levels map[string]string `json:"levels"`
+}
+
+// errorPayload reports a failed request
+type errorPayload struct {
+	Error string `json:"error"`
+}
+
+// LevelHandler returns an http.Handler for inspecting and changing channel
+// levels at runtime, similar to zap's AtomicLevel endpoint:
+//
+//	GET /                      {"levels":{"file":"debug","slack":"error"}}
+//	GET /?channel=file         {"channel":"file","level":"debug"}
+//	PUT {"channel":"file","level":"info"}
+//
+// The handler does no authentication; mount it on an internal listener.
+func (m *Manager) LevelHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			m.serveGetLevel(w, r)
+		case http.MethodPut:
+			m.servePutLevel(w, r)
+		default:
+			w.Header().Set("Allow", "GET, PUT")
+			writeLevelJSON(w, http.StatusMethodNotAllowed, errorPayload{
+				Error: "only GET and PUT are supported",
+			})
+		}
+	})
+}
+
+// serveGetLevel reports one channel's level, or all levels without ?channel=
+func (m *Manager) serveGetLevel(w http.ResponseWriter, r *http.Request) {
+	name := r.URL.Query().Get("channel")
+	if name == "" {
+		levels := make(map[string]string)
+		for ch, level := range m.Levels() {
+			levels[ch] = strings.ToLower(level.String())
+		}
+		writeLevelJSON(w, http.StatusOK, levelsPayload{Levels: levels})
+		return
+	}
+
+	level, err := m.Level(name)
+	if err != nil {
+		writeLevelJSON(w, http.StatusNotFound, errorPayload{Error: err.Error()})
+		return
+	}
+	writeLevelJSON(w, http.StatusOK, levelPayload{Channel: name, Level: strings.ToLower(level.String())})
+}
+
+// servePutLevel changes a channel's level from a JSON body
+func (m *Manager) servePutLevel(w http.ResponseWriter, r *http.Request) {
+	var req levelPayload
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeLevelJSON(w, http.StatusBadRequest, errorPayload{Error: "invalid JSON body: " + err.Error()})
+		return
+	}
+	if req.Channel == "" {
+		req.Channel = r.URL.Query().Get("channel")
+	}
+	if req.Channel == "" {
+		writeLevelJSON(w, http.StatusBadRequest, errorPayload{Error: "channel is required"})
+		return
+	}
+
+	level, err := parseLevel(req.Level)
+	if err != nil {
+		writeLevelJSON(w, http.StatusBadRequest, errorPayload{Error: err.Error()})
+		return
+	}
+
+	if _, err := m.Level(req.Channel); err != nil {
+		writeLevelJSON(w, http.StatusNotFound, errorPayload{Error: err.Error()})
+		return
+	}
+	if err := m.SetLevel(req.Channel, level); err != nil {
+		writeLevelJSON(w, http.StatusInternalServerError, errorPayload{Error: err.Error()})
+		return
+	}
+
+	writeLevelJSON(w, http.StatusOK, levelPayload{Channel: req.Channel, Level: strings.ToLower(level.String())})
+}
+
+// writeLevelJSON writes v as a JSON response with the given status
+func writeLevelJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
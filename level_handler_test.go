@@ -0,0 +1,57 @@
+package golog
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// levelChannels are mock channels at different levels
+var levelChannels = map[string]ChannelConfig{
+	"test":  {Driver: "mock", Level: "warning"},
+	"audit": {Driver: "mock", Level: "error"},
+}
+
+func TestLevelHandler(t *testing.T) {
+	manager, _ := newMockManager(t, levelChannels)
+	handler := manager.LevelHandler()
+
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{"get all", http.MethodGet, "/", "", http.StatusOK, `{"levels":{"audit":"error","test":"warning"}}`},
+		{"get one", http.MethodGet, "/?channel=test", "", http.StatusOK, `{"channel":"test","level":"warning"}`},
+		{"get unknown", http.MethodGet, "/?channel=nope", "", http.StatusNotFound, `channel [nope] is not defined`},
+		{"put", http.MethodPut, "/", `{"channel":"test","level":"debug"}`, http.StatusOK, `{"channel":"test","level":"debug"}`},
+		{"put query channel", http.MethodPut, "/?channel=audit", `{"level":"info"}`, http.StatusOK, `{"channel":"audit","level":"info"}`},
+		{"put bad level", http.MethodPut, "/", `{"channel":"test","level":"loud"}`, http.StatusBadRequest, `level [loud] is not valid`},
+		{"put bad json", http.MethodPut, "/", `{`, http.StatusBadRequest, `invalid JSON body`},
+		{"put no channel", http.MethodPut, "/", `{"level":"info"}`, http.StatusBadRequest, `channel is required`},
+		{"put unknown", http.MethodPut, "/", `{"channel":"nope","level":"info"}`, http.StatusNotFound, `channel [nope] is not defined`},
+		{"post", http.MethodPost, "/", "", http.StatusMethodNotAllowed, `only GET and PUT`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body)))
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d", tt.wantStatus, rec.Code)
+			}
+			if !strings.Contains(rec.Body.String(), tt.wantBody) {
+				t.Errorf("Expected %q in body %q", tt.wantBody, rec.Body.String())
+			}
+		})
+	}
+
+	if level, _ := manager.Level("test"); level != DebugLevel {
+		t.Errorf("Expected PUT to change the level, got %s", level)
+	}
+}
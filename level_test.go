@@ -1,6 +1,9 @@
 package golog
 
-import "testing"
+import (
+	"sync"
+	"testing"
+)
 
 func TestLevel_String(t *testing.T) {
 	tests := []struct {
@@ -109,3 +112,54 @@ func TestLevel_Color(t *testing.T) {
 		}
 	}
 }
+
+func TestLevel_SyslogSeverity(t *testing.T) {
+	tests := []struct {
+		level    Level
+		expected int
+	}{
+		{DebugLevel, 7},
+		{InfoLevel, 6},
+		{NoticeLevel, 5},
+		{WarningLevel, 4},
+		{ErrorLevel, 3},
+		{CriticalLevel, 2},
+		{AlertLevel, 1},
+		{EmergencyLevel, 0},
+		{Level(99), 6},
+	}
+
+	for _, tt := range tests {
+		if got := tt.level.SyslogSeverity(); got != tt.expected {
+			t.Errorf("%s.SyslogSeverity() = %d, want %d", tt.level, got, tt.expected)
+		}
+	}
+}
+
+func TestParseLevel_Invalid(t *testing.T) {
+	if _, err := parseLevel("loud"); err == nil {
+		t.Error("Expected error for unknown level")
+	}
+	if level := ParseLevel("loud"); level != InfoLevel {
+		t.Errorf("Expected ParseLevel to fall back to INFO, got %s", level)
+	}
+}
+
+func TestAtomicLevel_Concurrent(t *testing.T) {
+	level := NewAtomicLevel(InfoLevel)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			level.SetLevel(Level(i))
+			_ = level.Enabled(ErrorLevel)
+		}(i)
+	}
+	wg.Wait()
+
+	if l := level.Level(); l < DebugLevel || l > EmergencyLevel {
+		t.Errorf("Unexpected level %d", l)
+	}
+}
@@ -0,0 +1,182 @@
+package golog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MonologLineFormat is Monolog's default LineFormatter template, as used by Laravel
+const MonologLineFormat = "[%datetime%] %channel%.%level_name%: %message% %context% %extra%\n"
+
+// templatePlaceholder matches %name% and %context.key% / %extra.key% placeholders
+var templatePlaceholder = regexp.MustCompile(`%(?:datetime|channel|level_name|level|message|context|extra|caller|(?:context|extra)\.[^%\s]+)%`)
+
+// trailingSpaces matches spaces left at line ends after dropping empty placeholders
+var trailingSpaces = regexp.MustCompile(`[ \t]+(\r?\n|$)`)
+
+// monologLevels maps golog levels to Monolog's numeric levels for %level%
+var monologLevels = map[Level]int{
+	DebugLevel:     100,
+	InfoLevel:      200,
+	NoticeLevel:    250,
+	WarningLevel:   300,
+	ErrorLevel:     400,
+	CriticalLevel:  500,
+	AlertLevel:     550,
+	EmergencyLevel: 600,
+}
+
+// formatTemplate renders the entry using the Monolog-style template in f.Template.
+//
+// Supported placeholders: %datetime%, %channel%, %level_name%, %level%, %message%,
+// %context%, %extra%, %caller%, %context.key% and %extra.key%. Keys rendered inline are
+// left out of %context% and %extra%.
+func (f *LineFormatter) formatTemplate(entry *Entry) ([]byte, error) {
+	dateFormat := f.DateFormat
+	if dateFormat == "" {
+		dateFormat = "2006-01-02 15:04:05"
+	}
+
+	context := make(map[string]any, len(entry.Context)+1)
+	for k, v := range entry.Context {
+		context[k] = v
+	}
+	if entry.Exception != nil {
+		if _, exists := context["exception"]; !exists {
+			context["exception"] = monologException(entry.Exception)
+		}
+	}
+
+	extra := make(map[string]any, len(entry.Extra))
+	for k, v := range entry.Extra {
+		extra[k] = v
+	}
+
+	// Inline keys are consumed before %context% and %extra% are rendered
+	inline := make(map[string]string)
+	for _, ph := range templatePlaceholder.FindAllString(f.Template, -1) {
+		name := strings.Trim(ph, "%")
+		if key, ok := strings.CutPrefix(name, "context."); ok {
+			if v, exists := context[key]; exists {
+				inline[ph] = f.templateValue(v)
+				delete(context, key)
+			}
+		} else if key, ok := strings.CutPrefix(name, "extra."); ok {
+			if v, exists := extra[key]; exists {
+				inline[ph] = f.templateValue(v)
+				delete(extra, key)
+			}
+		}
+	}
+
+	channel := entry.Channel
+	if channel == "" {
+		channel = "local"
+	}
+
+	out := templatePlaceholder.ReplaceAllStringFunc(f.Template, func(ph string) string {
+		switch ph {
+		case "%datetime%":
+			return entry.Timestamp.Format(dateFormat)
+		case "%channel%":
+			return channel
+		case "%level_name%":
+			return entry.Level.String()
+		case "%level%":
+			return fmt.Sprintf("%d", monologLevels[entry.Level])
+		case "%message%":
+			return f.templateValue(entry.Message)
+		case "%caller%":
+			if entry.Caller == nil {
+				return ""
+			}
+			return fmt.Sprintf("%s:%d", entry.Caller.File, entry.Caller.Line)
+		case "%context%":
+			if len(context) == 0 && f.IgnoreEmptyContextAndExtra {
+				return ""
+			}
+			return f.templateValue(context)
+		case "%extra%":
+			if len(extra) == 0 && f.IgnoreEmptyContextAndExtra {
+				return ""
+			}
+			return f.templateValue(extra)
+		default:
+			// Unknown %context.key% / %extra.key% placeholders are removed
+			return inline[ph]
+		}
+	})
+
+	if f.IgnoreEmptyContextAndExtra {
+		out = trailingSpaces.ReplaceAllString(out, "$1")
+	}
+
+	return []byte(out), nil
+}
+
+// templateValue converts a value to its template form: scalars as text,
+// maps and slices as compact JSON ("[]" when empty, like Monolog)
+func (f *LineFormatter) templateValue(v any) string {
+	var s string
+	switch val := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		s = val
+	case []byte:
+		s = string(val)
+	case error:
+		s = val.Error()
+	case map[string]any:
+		if len(val) == 0 {
+			return "[]"
+		}
+		s = templateJSON(jsonContext(val, false), val)
+	default:
+		if isScalar(val) {
+			s = fmt.Sprintf("%v", val)
+		} else {
+			s = templateJSON(val, val)
+		}
+	}
+
+	if f.AllowInlineLineBreaks {
+		if strings.HasPrefix(s, "{") || strings.HasPrefix(s, "[") {
+			// Turn JSON-escaped line breaks back into real ones
+			s = strings.NewReplacer(`\r`, "\r", `\n`, "\n").Replace(s)
+		}
+		return s
+	}
+
+	return strings.NewReplacer("\r\n", " ", "\r", " ", "\n", " ").Replace(s)
+}
+
+// templateJSON encodes v as compact JSON without HTML escaping,
+// falling back to %v of orig when v cannot be encoded
+func templateJSON(v, orig any) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return fmt.Sprintf("%v", orig)
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// isScalar reports whether v is rendered as plain text rather than JSON
+func isScalar(v any) bool {
+	switch v.(type) {
+	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64, fmt.Stringer:
+		return true
+	default:
+		return false
+	}
+}
+
+// monologException renders exception details the way Monolog normalizes throwables
+func monologException(ex *ExceptionInfo) string {
+	return fmt.Sprintf("[object] (%s(code: %d): %s at %s:%d)", ex.Class, ex.Code, ex.Message, ex.File, ex.Line)
+}
@@ -0,0 +1,128 @@
+package golog
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func newTemplateEntry() *Entry {
+	entry := NewEntry(InfoLevel, "User logged in")
+	entry.Timestamp = time.Date(2024, 1, 15, 10, 30, 45, 0, time.UTC)
+	entry.SetChannel("production")
+	return entry
+}
+
+func TestLineFormatter_MonologDefault(t *testing.T) {
+	entry := newTemplateEntry()
+	entry.With("user_id", 123)
+
+	f := &LineFormatter{DateFormat: "2006-01-02 15:04:05", Template: MonologLineFormat}
+	out, err := f.Format(entry)
+	if err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+
+	want := `[2024-01-15 10:30:45] production.INFO: User logged in {"user_id":123} []` + "\n"
+	if string(out) != want {
+		t.Errorf("Format() = %q, want %q", out, want)
+	}
+}
+
+func TestLineFormatter_TemplateEmptyContext(t *testing.T) {
+	tests := []struct {
+		name   string
+		ignore bool
+		want   string
+	}{
+		{"rendered as brackets", false, "[2024-01-15 10:30:45] production.INFO: User logged in [] []\n"},
+		{"ignored", true, "[2024-01-15 10:30:45] production.INFO: User logged in\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &LineFormatter{Template: MonologLineFormat, IgnoreEmptyContextAndExtra: tt.ignore}
+			out, _ := f.Format(newTemplateEntry())
+			if string(out) != tt.want {
+				t.Errorf("Format() = %q, want %q", out, tt.want)
+			}
+		})
+	}
+}
+
+func TestLineFormatter_TemplateLineBreaks(t *testing.T) {
+	entry := newTemplateEntry()
+	entry.Message = "first\nsecond"
+	entry.With("sql", "SELECT *\nFROM users")
+
+	f := &LineFormatter{Template: "%message% %context%\n"}
+	out, _ := f.Format(entry)
+	want := `first second {"sql":"SELECT *\nFROM users"}` + "\n"
+	if string(out) != want {
+		t.Errorf("Format() = %q, want %q", out, want)
+	}
+
+	f.AllowInlineLineBreaks = true
+	out, _ = f.Format(entry)
+	want = "first\nsecond {\"sql\":\"SELECT *\nFROM users\"}\n"
+	if string(out) != want {
+		t.Errorf("Format() = %q, want %q", out, want)
+	}
+}
+
+func TestLineFormatter_TemplatePlaceholders(t *testing.T) {
+	entry := newTemplateEntry()
+	entry.Level = WarningLevel
+	entry.With("request_id", "abc")
+	entry.With("user_id", 7)
+	entry.Extra = map[string]any{"pid": 42}
+
+	f := &LineFormatter{
+		DateFormat: time.RFC3339,
+		Template:   "%datetime% %level%/%level_name% [%context.request_id%] [%extra.pid%] [%context.missing%] %message% %context% %extra%\n",
+	}
+	out, _ := f.Format(entry)
+
+	want := `2024-01-15T10:30:45Z 300/WARNING [abc] [42] [] User logged in {"user_id":7} []` + "\n"
+	if string(out) != want {
+		t.Errorf("Format() = %q, want %q", out, want)
+	}
+}
+
+func TestLineFormatter_TemplateException(t *testing.T) {
+	entry := newTemplateEntry()
+	entry.WithException("DatabaseError", "timeout", 500, "/app/db.go", 42, nil)
+
+	f := &LineFormatter{Template: "%context%"}
+	out, _ := f.Format(entry)
+
+	want := `{"exception":"[object] (DatabaseError(code: 500): timeout at /app/db.go:42)"}`
+	if string(out) != want {
+		t.Errorf("Format() = %q, want %q", out, want)
+	}
+}
+
+func TestFileDriver_LineFormatConfig(t *testing.T) {
+	logPath := filepath.Join(t.TempDir(), "laravel.log")
+
+	config := NewFileChannelConfig(logPath)
+	config.FormatterConfig = &FormatterConfig{
+		LineFormat:                 MonologLineFormat,
+		IgnoreEmptyContextAndExtra: true,
+	}
+
+	driver, err := NewFileDriver(config)
+	if err != nil {
+		t.Fatalf("NewFileDriver failed: %v", err)
+	}
+
+	driver.Log(newTemplateEntry())
+	driver.Close()
+
+	content, _ := os.ReadFile(logPath)
+	want := "[2024-01-15 10:30:45] production.INFO: User logged in\n"
+	if string(content) != want {
+		t.Errorf("Expected %q, got %q", want, content)
+	}
+}
@@ -0,0 +1,170 @@
+package golog
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// LogfmtFormatter formats entries as logfmt key=value pairs:
+//
+//	ts=2024-01-15T10:30:45Z level=info channel=app msg="User logged in" user_id=123
+//
+// Nested maps in the context are flattened using dotted keys.
+type LogfmtFormatter struct {
+	// AppName is included as "app" when set
+	AppName string
+}
+
+// NewLogfmtFormatter creates a logfmt formatter
+func NewLogfmtFormatter(appName string) *LogfmtFormatter {
+	return &LogfmtFormatter{AppName: appName}
+}
+
+// newLogfmtFormatterFromConfig creates a logfmt formatter from channel configuration
+func newLogfmtFormatterFromConfig(config ChannelConfig) (Formatter, error) {
+	return NewLogfmtFormatter(config.AppName), nil
+}
+
+// Format serializes the entry on a single logfmt line
+func (f *LogfmtFormatter) Format(entry *Entry) ([]byte, error) {
+	var b strings.Builder
+
+	writeLogfmtPair(&b, "ts", entry.Timestamp.Format(time.RFC3339Nano))
+	writeLogfmtPair(&b, "level", strings.ToLower(entry.Level.String()))
+	if entry.Channel != "" {
+		writeLogfmtPair(&b, "channel", entry.Channel)
+	}
+	if f.AppName != "" {
+		writeLogfmtPair(&b, "app", f.AppName)
+	}
+	writeLogfmtPair(&b, "msg", entry.Message)
+
+	fields := make(map[string]string)
+	flattenLogfmt(fields, "", entry.Context)
+	flattenLogfmt(fields, "extra", entry.Extra)
+
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		writeLogfmtPair(&b, k, fields[k])
+	}
+
+	if c := entry.Caller; c != nil {
+		writeLogfmtPair(&b, "caller", fmt.Sprintf("%s:%d", c.File, c.Line))
+		if c.Function != "" {
+			writeLogfmtPair(&b, "func", c.Function)
+		}
+	}
+
+	if ex := entry.Exception; ex != nil {
+		writeLogfmtPair(&b, "exception.class", ex.Class)
+		writeLogfmtPair(&b, "exception.message", ex.Message)
+		if ex.Code != 0 {
+			writeLogfmtPair(&b, "exception.code", strconv.Itoa(ex.Code))
+		}
+		if ex.File != "" {
+			writeLogfmtPair(&b, "exception.file", fmt.Sprintf("%s:%d", ex.File, ex.Line))
+		}
+	}
+
+	b.WriteString("\n")
+	return []byte(b.String()), nil
+}
+
+// flattenLogfmt stores the string form of every value in ctx under its dotted key
+func flattenLogfmt(fields map[string]string, prefix string, ctx map[string]any) {
+	for k, v := range ctx {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+
+		switch val := v.(type) {
+		case map[string]any:
+			flattenLogfmt(fields, key, val)
+		case map[string]string:
+			for sk, sv := range val {
+				fields[key+"."+sk] = sv
+			}
+		default:
+			fields[key] = logfmtValue(val)
+		}
+	}
+}
+
+// logfmtValue converts a context value to its unquoted logfmt form
+func logfmtValue(v any) string {
+	switch val := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return val
+	case []byte:
+		return string(val)
+	case error:
+		return val.Error()
+	case time.Time:
+		return val.Format(time.RFC3339Nano)
+	case time.Duration:
+		return val.String()
+	case fmt.Stringer:
+		return val.String()
+	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return fmt.Sprintf("%v", val)
+	default:
+		// Slices and structs are rendered as compact JSON
+		if b, err := json.Marshal(val); err == nil {
+			return string(b)
+		}
+		return fmt.Sprintf("%v", val)
+	}
+}
+
+// writeLogfmtPair appends key=value, separated from any previous pair by a space
+func writeLogfmtPair(b *strings.Builder, key, value string) {
+	if b.Len() > 0 {
+		b.WriteByte(' ')
+	}
+	b.WriteString(logfmtKey(key))
+	b.WriteByte('=')
+	if needsLogfmtQuoting(value) {
+		b.WriteString(strconv.Quote(value))
+	} else {
+		b.WriteString(value)
+	}
+}
+
+// logfmtKey replaces characters that are not allowed in logfmt keys
+func logfmtKey(key string) string {
+	if key == "" {
+		return "_"
+	}
+	return strings.Map(func(r rune) rune {
+		if r <= ' ' || r == '=' || r == '"' || r == utf8.RuneError {
+			return '_'
+		}
+		return r
+	}, key)
+}
+
+// needsLogfmtQuoting reports whether value must be quoted to remain one token
+func needsLogfmtQuoting(value string) bool {
+	if value == "" {
+		return true
+	}
+	for _, r := range value {
+		if r <= ' ' || r == '=' || r == '"' || r == '\\' || r == utf8.RuneError || !unicode.IsPrint(r) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+package golog
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLogfmtFormatter_Format(t *testing.T) {
+	entry := NewEntry(InfoLevel, "User logged in")
+	entry.Timestamp = time.Date(2024, 1, 15, 10, 30, 45, 0, time.UTC)
+	entry.SetChannel("app")
+	entry.With("user_id", 123)
+
+	out, err := NewLogfmtFormatter("").Format(entry)
+	if err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+
+	want := `ts=2024-01-15T10:30:45Z level=info channel=app msg="User logged in" user_id=123` + "\n"
+	if string(out) != want {
+		t.Errorf("Format() = %q, want %q", out, want)
+	}
+}
+
+func TestLogfmtFormatter_Quoting(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		want  string
+	}{
+		{"plain", "abc", "k=abc"},
+		{"empty", "", `k=""`},
+		{"space", "a b", `k="a b"`},
+		{"equals", "a=b", `k="a=b"`},
+		{"quote", `say "hi"`, `k="say \"hi\""`},
+		{"backslash", `C:\tmp`, `k="C:\\tmp"`},
+		{"newline", "line1\nline2", `k="line1\nline2"`},
+		{"nil", nil, "k=null"},
+		{"bool", true, "k=true"},
+		{"float", 20.9, "k=20.9"},
+		{"error", errors.New("connection refused"), `k="connection refused"`},
+		{"duration", 1500 * time.Millisecond, "k=1.5s"},
+		{"slice", []int{1, 2}, "k=[1,2]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := NewEntry(InfoLevel, "m")
+			entry.With("k", tt.value)
+
+			out, _ := NewLogfmtFormatter("").Format(entry)
+			if !strings.Contains(string(out), " "+tt.want) {
+				t.Errorf("Expected %q in %q", tt.want, out)
+			}
+		})
+	}
+}
+
+func TestLogfmtFormatter_NestedContext(t *testing.T) {
+	entry := NewEntry(InfoLevel, "order")
+	entry.WithContext(map[string]any{
+		"user": map[string]any{
+			"id":      7,
+			"address": map[string]any{"city": "Riyadh"},
+		},
+		"tags": map[string]string{"env": "prod"},
+	})
+
+	out, _ := NewLogfmtFormatter("").Format(entry)
+	line := string(out)
+
+	want := "tags.env=prod user.address.city=Riyadh user.id=7"
+	if !strings.Contains(line, want) {
+		t.Errorf("Expected sorted dotted keys %q in %q", want, line)
+	}
+}
+
+func TestLogfmtFormatter_KeysAndException(t *testing.T) {
+	entry := NewEntry(ErrorLevel, "failed")
+	entry.With("bad key=x", 1)
+	entry.WithException("DatabaseError", "timeout", 500, "/app/db.go", 42, nil)
+
+	out, _ := NewLogfmtFormatter("Shop").Format(entry)
+	line := string(out)
+
+	for _, want := range []string{
+		"app=Shop",
+		"bad_key_x=1",
+		"exception.class=DatabaseError",
+		"exception.code=500",
+		"exception.file=/app/db.go:42",
+	} {
+		if !strings.Contains(line, want) {
+			t.Errorf("Expected %q in %q", want, line)
+		}
+	}
+}
+
+func TestNewFormatter_Logfmt(t *testing.T) {
+	formatter, err := NewFormatter(ChannelConfig{Formatter: "logfmt", AppName: "App"})
+	if err != nil {
+		t.Fatalf("NewFormatter failed: %v", err)
+	}
+
+	lf, ok := formatter.(*LogfmtFormatter)
+	if !ok {
+		t.Fatalf("Expected *LogfmtFormatter, got %T", formatter)
+	}
+	if lf.AppName != "App" {
+		t.Errorf("Expected app name 'App', got %q", lf.AppName)
+	}
+}
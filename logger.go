@@ -1,6 +1,10 @@
 package golog
 
-import "sync"
+import (
+	"context"
+	"fmt"
+	"sync"
+)
 
 // Logger provides logging methods for a specific channel
 type Logger struct {
@@ -76,41 +80,45 @@ func (l *Logger) WithoutContext(keys ...string) *Logger {
 	}
 }
 
+// enabled reports whether the channel accepts entries at level
+func (l *Logger) enabled(level Level) bool {
+	return l.channel.level.Enabled(level)
+}
+
+// capturesCaller reports whether entries at level record their caller
+func (l *Logger) capturesCaller(level Level) bool {
+	return l.channel.caller.Enabled(level)
+}
+
 // log writes a log entry at the given level
 func (l *Logger) log(level Level, message string, context map[string]any) {
 	// Check if level meets minimum
-	if level < l.channel.level {
+	if !l.enabled(level) {
 		return
 	}
 
-	entry := NewEntry(level, message)
-	entry.SetChannel(l.channel.name)
-
-	// Add context
-	l.mu.RLock()
-	for k, v := range l.ctx {
-		entry.Context[k] = v
-	}
-	l.mu.RUnlock()
-
-	for k, v := range context {
-		entry.Context[k] = v
-	}
-
-	// Write to driver
-	_ = l.channel.driver.Log(entry)
+	l.write(NewEntry(level, message), context)
 }
 
 // logWithError writes a log entry with error information
 func (l *Logger) logWithError(level Level, message string, err error, context map[string]any) {
 	// Check if level meets minimum
-	if level < l.channel.level {
+	if !l.enabled(level) {
 		return
 	}
 
 	entry := NewEntry(level, message)
-	entry.SetChannel(l.channel.name)
 	entry.WithError(err)
+	l.write(entry, context)
+}
+
+// write adds the channel, caller and context to an entry and sends it to the driver
+func (l *Logger) write(entry *Entry, context map[string]any) {
+	entry.SetChannel(l.channel.name)
+
+	if entry.Caller == nil && l.capturesCaller(entry.Level) {
+		entry.Caller = captureCaller()
+	}
 
 	// Add context
 	l.mu.RLock()
@@ -123,8 +131,15 @@ func (l *Logger) logWithError(level Level, message string, err error, context ma
 		entry.Context[k] = v
 	}
 
-	// Write to driver
-	_ = l.channel.driver.Log(entry)
+	// Run manager processors; the channel's own run in process
+	if entry = runProcessors(entry, l.manager.Processors()); entry == nil {
+		return
+	}
+
+	// Write to driver, reporting failures to the manager's error handler
+	if err := l.channel.process(entry); err != nil {
+		l.manager.handleDriverError(l.channel.name, entry, err)
+	}
 }
 
 // Debug logs a debug message
@@ -205,6 +220,193 @@ func (l *Logger) Log(level Level, message string, context ...map[string]any) {
 	l.log(level, message, ctx)
 }
 
+// logContext writes a log entry including the fields carried by ctx
+func (l *Logger) logContext(ctx context.Context, level Level, message string, fields []map[string]any) {
+	merged := mergeContext(append([]map[string]any{FieldsFromContext(ctx)}, fields...)...)
+	l.log(level, message, merged)
+}
+
+// DebugContext logs a debug message with the fields carried by ctx
+func (l *Logger) DebugContext(ctx context.Context, message string, fields ...map[string]any) {
+	l.logContext(ctx, DebugLevel, message, fields)
+}
+
+// InfoContext logs an info message with the fields carried by ctx
+func (l *Logger) InfoContext(ctx context.Context, message string, fields ...map[string]any) {
+	l.logContext(ctx, InfoLevel, message, fields)
+}
+
+// NoticeContext logs a notice message with the fields carried by ctx
+func (l *Logger) NoticeContext(ctx context.Context, message string, fields ...map[string]any) {
+	l.logContext(ctx, NoticeLevel, message, fields)
+}
+
+// WarningContext logs a warning message with the fields carried by ctx
+func (l *Logger) WarningContext(ctx context.Context, message string, fields ...map[string]any) {
+	l.logContext(ctx, WarningLevel, message, fields)
+}
+
+// ErrorContext logs an error message with the fields carried by ctx
+func (l *Logger) ErrorContext(ctx context.Context, message string, fields ...map[string]any) {
+	l.logContext(ctx, ErrorLevel, message, fields)
+}
+
+// CriticalContext logs a critical message with the fields carried by ctx
+func (l *Logger) CriticalContext(ctx context.Context, message string, fields ...map[string]any) {
+	l.logContext(ctx, CriticalLevel, message, fields)
+}
+
+// AlertContext logs an alert message with the fields carried by ctx
+func (l *Logger) AlertContext(ctx context.Context, message string, fields ...map[string]any) {
+	l.logContext(ctx, AlertLevel, message, fields)
+}
+
+// EmergencyContext logs an emergency message with the fields carried by ctx
+func (l *Logger) EmergencyContext(ctx context.Context, message string, fields ...map[string]any) {
+	l.logContext(ctx, EmergencyLevel, message, fields)
+}
+
+// LogContext logs a message at the specified level with the fields carried by ctx
+func (l *Logger) LogContext(ctx context.Context, level Level, message string, fields ...map[string]any) {
+	l.logContext(ctx, level, message, fields)
+}
+
+// badKey is used for key-value arguments that are not preceded by a string key,
+// matching log/slog
+const badKey = "!BADKEY"
+
+// logf formats and writes a log entry, skipping formatting for disabled levels
+func (l *Logger) logf(level Level, format string, args []any) {
+	if !l.enabled(level) {
+		return
+	}
+	l.log(level, fmt.Sprintf(format, args...), nil)
+}
+
+// logw writes a log entry with context built from alternating keys and values
+func (l *Logger) logw(level Level, message string, keysAndValues []any) {
+	if !l.enabled(level) {
+		return
+	}
+	l.log(level, message, keyValueContext(keysAndValues))
+}
+
+// keyValueContext builds a context map from alternating keys and values.
+// Like log/slog, a value without a string key (including a trailing lone
+// argument) is stored under "!BADKEY".
+func keyValueContext(keysAndValues []any) map[string]any {
+	ctx := make(map[string]any, len(keysAndValues)/2)
+	for i := 0; i < len(keysAndValues); {
+		key, ok := keysAndValues[i].(string)
+		if !ok || i+1 >= len(keysAndValues) {
+			ctx[badKey] = keysAndValues[i]
+			i++
+			continue
+		}
+		ctx[key] = keysAndValues[i+1]
+		i += 2
+	}
+	return ctx
+}
+
+// Debugf logs a debug message formatted with fmt.Sprintf
+func (l *Logger) Debugf(format string, args ...any) {
+	l.logf(DebugLevel, format, args)
+}
+
+// Infof logs an info message formatted with fmt.Sprintf
+func (l *Logger) Infof(format string, args ...any) {
+	l.logf(InfoLevel, format, args)
+}
+
+// Noticef logs a notice message formatted with fmt.Sprintf
+func (l *Logger) Noticef(format string, args ...any) {
+	l.logf(NoticeLevel, format, args)
+}
+
+// Warningf logs a warning message formatted with fmt.Sprintf
+func (l *Logger) Warningf(format string, args ...any) {
+	l.logf(WarningLevel, format, args)
+}
+
+// Errorf logs an error message formatted with fmt.Sprintf
+func (l *Logger) Errorf(format string, args ...any) {
+	l.logf(ErrorLevel, format, args)
+}
+
+// Criticalf logs a critical message formatted with fmt.Sprintf
+func (l *Logger) Criticalf(format string, args ...any) {
+	l.logf(CriticalLevel, format, args)
+}
+
+// Alertf logs an alert message formatted with fmt.Sprintf
+func (l *Logger) Alertf(format string, args ...any) {
+	l.logf(AlertLevel, format, args)
+}
+
+// Emergencyf logs an emergency message formatted with fmt.Sprintf
+func (l *Logger) Emergencyf(format string, args ...any) {
+	l.logf(EmergencyLevel, format, args)
+}
+
+// Logf logs a message formatted with fmt.Sprintf at the specified level
+func (l *Logger) Logf(level Level, format string, args ...any) {
+	l.logf(level, format, args)
+}
+
+// Debugw logs a debug message with alternating key-value context,
+// e.g. Debugw("msg", "user_id", 1)
+func (l *Logger) Debugw(message string, keysAndValues ...any) {
+	l.logw(DebugLevel, message, keysAndValues)
+}
+
+// Infow logs an info message with alternating key-value context,
+// e.g. Infow("msg", "user_id", 1)
+func (l *Logger) Infow(message string, keysAndValues ...any) {
+	l.logw(InfoLevel, message, keysAndValues)
+}
+
+// Noticew logs a notice message with alternating key-value context,
+// e.g. Noticew("msg", "user_id", 1)
+func (l *Logger) Noticew(message string, keysAndValues ...any) {
+	l.logw(NoticeLevel, message, keysAndValues)
+}
+
+// Warningw logs a warning message with alternating key-value context,
+// e.g. Warningw("msg", "user_id", 1)
+func (l *Logger) Warningw(message string, keysAndValues ...any) {
+	l.logw(WarningLevel, message, keysAndValues)
+}
+
+// Errorw logs an error message with alternating key-value context,
+// e.g. Errorw("msg", "user_id", 1)
+func (l *Logger) Errorw(message string, keysAndValues ...any) {
+	l.logw(ErrorLevel, message, keysAndValues)
+}
+
+// Criticalw logs a critical message with alternating key-value context,
+// e.g. Criticalw("msg", "user_id", 1)
+func (l *Logger) Criticalw(message string, keysAndValues ...any) {
+	l.logw(CriticalLevel, message, keysAndValues)
+}
+
+// Alertw logs an alert message with alternating key-value context,
+// e.g. Alertw("msg", "user_id", 1)
+func (l *Logger) Alertw(message string, keysAndValues ...any) {
+	l.logw(AlertLevel, message, keysAndValues)
+}
+
+// Emergencyw logs an emergency message with alternating key-value context,
+// e.g. Emergencyw("msg", "user_id", 1)
+func (l *Logger) Emergencyw(message string, keysAndValues ...any) {
+	l.logw(EmergencyLevel, message, keysAndValues)
+}
+
+// Logw logs a message with alternating key-value context at the specified level
+func (l *Logger) Logw(level Level, message string, keysAndValues ...any) {
+	l.logw(level, message, keysAndValues)
+}
+
 // mergeContext merges multiple context maps
 func mergeContext(contexts ...map[string]any) map[string]any {
 	result := make(map[string]any)
@@ -386,3 +386,104 @@ func TestMergeContext_Empty(t *testing.T) {
 	}
 }
 
+func TestLogger_FormattedMethods(t *testing.T) {
+	manager, driver := newMockManager(t, map[string]ChannelConfig{"test": {Driver: "mock", Level: "debug"}})
+	logger, _ := manager.Channel("test")
+
+	logger.Debugf("debug %d", 1)
+	logger.Infof("info %d", 2)
+	logger.Noticef("notice %d", 3)
+	logger.Warningf("warning %d", 4)
+	logger.Errorf("error %d", 5)
+	logger.Criticalf("critical %d", 6)
+	logger.Alertf("alert %d", 7)
+	logger.Emergencyf("emergency %d", 8)
+	logger.Logf(InfoLevel, "log %s", "x")
+
+	want := []string{"debug 1", "info 2", "notice 3", "warning 4", "error 5", "critical 6", "alert 7", "emergency 8", "log x"}
+	if len(driver.entries) != len(want) {
+		t.Fatalf("Expected %d entries, got %d", len(want), len(driver.entries))
+	}
+	for i, msg := range want {
+		if driver.entries[i].Message != msg {
+			t.Errorf("entry %d: expected %q, got %q", i, msg, driver.entries[i].Message)
+		}
+	}
+	if driver.entries[7].Level != EmergencyLevel {
+		t.Errorf("Expected emergency level, got %s", driver.entries[7].Level)
+	}
+}
+
+func TestLogger_KeyValueMethods(t *testing.T) {
+	manager, driver := newMockManager(t, map[string]ChannelConfig{"test": {Driver: "mock", Level: "debug"}})
+	logger, _ := manager.Channel("test")
+
+	logger.Debugw("debug", "k", 1)
+	logger.Infow("info", "user_id", 1, "ip", "10.0.0.1")
+	logger.Noticew("notice")
+	logger.Warningw("warning", "k", 1)
+	logger.Errorw("error", "k", 1)
+	logger.Criticalw("critical", "k", 1)
+	logger.Alertw("alert", "k", 1)
+	logger.Emergencyw("emergency", "k", 1)
+	logger.Logw(ErrorLevel, "log", "k", 1)
+
+	if len(driver.entries) != 9 {
+		t.Fatalf("Expected 9 entries, got %d", len(driver.entries))
+	}
+
+	ctx := driver.entries[1].Context
+	if ctx["user_id"] != 1 || ctx["ip"] != "10.0.0.1" {
+		t.Errorf("Unexpected context %v", ctx)
+	}
+	if len(driver.entries[2].Context) != 0 {
+		t.Errorf("Expected empty context, got %v", driver.entries[2].Context)
+	}
+}
+
+func TestKeyValueContext(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []any
+		expected map[string]any
+	}{
+		{"pairs", []any{"a", 1, "b", 2}, map[string]any{"a": 1, "b": 2}},
+		{"odd length", []any{"a", 1, "dangling"}, map[string]any{"a": 1, badKey: "dangling"}},
+		{"non-string key", []any{42, "a", 1}, map[string]any{badKey: 42, "a": 1}},
+		{"empty", nil, map[string]any{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := keyValueContext(tt.args)
+			if len(got) != len(tt.expected) {
+				t.Fatalf("keyValueContext() = %v, want %v", got, tt.expected)
+			}
+			for k, v := range tt.expected {
+				if got[k] != v {
+					t.Errorf("keyValueContext()[%q] = %v, want %v", k, got[k], v)
+				}
+			}
+		})
+	}
+}
+
+func TestLogger_FormattedMethods_SkipDisabled(t *testing.T) {
+	manager, driver := newMockManager(t, map[string]ChannelConfig{"test": {Driver: "mock", Level: "error"}})
+	logger, _ := manager.Channel("test")
+
+	logger.Infof("%v", formatCounter{t})
+	logger.Infow("message", "k", 1)
+
+	if len(driver.entries) != 0 {
+		t.Errorf("Expected disabled levels to be skipped, got %d entries", len(driver.entries))
+	}
+}
+
+// formatCounter fails the test if it is ever formatted
+type formatCounter struct{ t *testing.T }
+
+func (f formatCounter) String() string {
+	f.t.Error("Expected formatting to be skipped for a disabled level")
+	return ""
+}
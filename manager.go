@@ -1,8 +1,11 @@
 package golog
 
 import (
+	"errors"
 	"fmt"
+	"slices"
 	"sync"
+	"sync/atomic"
 )
 
 // Manager manages multiple log channels like Laravel's LogManager
@@ -12,65 +15,119 @@ type Manager struct {
 	channels       map[string]*LogChannel
 	defaultChannel string
 	sharedContext  map[string]any
+	processors     []Processor
+
+	// reloadMu serializes Reload calls
+	reloadMu sync.Mutex
+
+	// Driver error handling, see SetErrorHandler. The handler and fallback
+	// channel are read without mu, as background writers report errors while
+	// Close or Reload may hold it.
+	errorHandler    atomic.Pointer[ErrorHandler]
+	fallbackChannel atomic.Pointer[string]
+	failuresMu      sync.Mutex
+	failures        map[string]uint64
+
+	// dropped counts entries dropped by asynchronous channels (guarded by
+	// failuresMu), see Dropped
+	dropped map[string]uint64
+
+	// closed is set by Close, after which failed entries are no longer
+	// written to the fallback channel
+	closed atomic.Bool
 }
 
 // LogChannel represents a logging channel with its driver and configuration
 type LogChannel struct {
-	name   string
-	driver Driver
-	level  Level
-	ctx    map[string]any
+	name  string
+	level *AtomicLevel
+	ctx   map[string]any
+
+	// caller is the minimum level for caller capture (callerDisabled when off)
+	caller *AtomicLevel
+
+	// mu guards driver and processors, which Manager.Reload may replace;
+	// writes hold the read lock so a replaced driver is closed only after them
+	mu         sync.RWMutex
+	driver     Driver
+	processors []Processor
 }
 
-// NewManager creates a new log manager with the given configuration
+// callerDisabled is a caller level above every real level, disabling capture
+const callerDisabled = EmergencyLevel + 1
+
+// NewManager creates a new log manager with the given configuration,
+// returning a *ValidationError if the configuration is invalid
 func NewManager(config *Config) (*Manager, error) {
 	if config == nil {
 		config = DefaultConfig()
 	}
 
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
 	m := &Manager{
 		config:         config,
 		channels:       make(map[string]*LogChannel),
 		defaultChannel: config.Default,
 		sharedContext:  make(map[string]any),
+		failures:       make(map[string]uint64),
+		dropped:        make(map[string]uint64),
 	}
+	m.setFallback(config.FallbackChannel)
 
 	return m, nil
 }
 
 // Channel returns a specific channel by name
 func (m *Manager) Channel(name string) (*Logger, error) {
-	m.mu.RLock()
-	ch, exists := m.channels[name]
-	m.mu.RUnlock()
-
-	if exists {
-		return NewLogger(ch, m), nil
-	}
+	for {
+		m.mu.RLock()
+		ch, exists := m.channels[name]
+		cfg := m.config
+		m.mu.RUnlock()
+
+		if exists {
+			return NewLogger(ch, m), nil
+		}
 
-	// Create the channel
-	ch, err := m.createChannel(name)
-	if err != nil {
-		return nil, err
-	}
+		// Create the channel
+		ch, err := m.createChannel(cfg, name)
+		if err != nil {
+			return nil, err
+		}
 
-	m.mu.Lock()
-	m.channels[name] = ch
-	m.mu.Unlock()
+		m.mu.Lock()
+		if existing, exists := m.channels[name]; exists || m.config != cfg {
+			// Another goroutine created the channel or the config was reloaded meanwhile
+			m.mu.Unlock()
+			ch.driver.Close()
+			if exists {
+				return NewLogger(existing, m), nil
+			}
+			continue
+		}
+		m.channels[name] = ch
+		m.mu.Unlock()
 
-	return NewLogger(ch, m), nil
+		return NewLogger(ch, m), nil
+	}
 }
 
 // createChannel creates a channel from configuration
-func (m *Manager) createChannel(name string) (*LogChannel, error) {
-	config, exists := m.config.Channels[name]
+func (m *Manager) createChannel(cfg *Config, name string) (*LogChannel, error) {
+	config, exists := cfg.Channels[name]
 	if !exists {
 		return nil, fmt.Errorf("channel [%s] is not defined", name)
 	}
 
 	// Handle stack driver
-	if config.Driver == "stack" {
-		return m.createStackChannel(name, config)
+	switch config.Driver {
+	case "stack":
+		return m.createStackChannel(cfg, name, config)
+	case "fallback":
+		return m.createFallbackChannel(cfg, name, config)
 	}
 
 	factory, exists := GetDriverFactory(config.Driver)
@@ -78,42 +135,164 @@ func (m *Manager) createChannel(name string) (*LogChannel, error) {
 		return nil, fmt.Errorf("driver [%s] is not supported", config.Driver)
 	}
 
-	driver, err := factory(config)
+	driver, err := factory(resolveConfig(cfg, config))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create driver [%s]: %w", config.Driver, err)
 	}
+	if driver, err = m.wrapAsync(name, driver, config); err != nil {
+		return nil, err
+	}
+	m.reportAsyncErrors(name, driver)
 
-	level := ParseLevel(config.Level)
+	return newLogChannel(name, driver, config)
+}
+
+// newLogChannel wraps a driver in a channel configured by config,
+// closing the driver if the configuration is unusable
+func newLogChannel(name string, driver Driver, config ChannelConfig) (*LogChannel, error) {
+	processors, err := channelProcessors(config)
+	if err != nil {
+		driver.Close()
+		return nil, err
+	}
 
 	return &LogChannel{
-		name:   name,
-		driver: driver,
-		level:  level,
-		ctx:    make(map[string]any),
+		name:       name,
+		driver:     driver,
+		level:      NewAtomicLevel(channelLevel(config)),
+		ctx:        make(map[string]any),
+		processors: processors,
+		caller:     NewAtomicLevel(callerLevel(config)),
 	}, nil
 }
 
+// isComposite reports whether a driver writes to other channels
+// listed in StackConfig.Channels
+func isComposite(driver string) bool {
+	return driver == "stack" || driver == "fallback"
+}
+
+// memberChannels returns the channels a stack or fallback channel writes to
+func memberChannels(config ChannelConfig) []string {
+	if !isComposite(config.Driver) || config.StackConfig == nil {
+		return nil
+	}
+	return config.StackConfig.Channels
+}
+
+// channelLevel returns the configured minimum level of a channel.
+// Stack and fallback channels default to debug so their members decide what to keep.
+func channelLevel(config ChannelConfig) Level {
+	if isComposite(config.Driver) && config.Level == "" {
+		return DebugLevel
+	}
+	return ParseLevel(config.Level)
+}
+
+// callerLevel returns the minimum level for caller capture on a channel
+func callerLevel(config ChannelConfig) Level {
+	if !config.Caller {
+		return callerDisabled
+	}
+	if config.CallerLevel == "" {
+		return DebugLevel
+	}
+	return ParseLevel(config.CallerLevel)
+}
+
+// channelProcessors resolves the processors configured for a channel
+func channelProcessors(config ChannelConfig) ([]Processor, error) {
+	var processors []Processor
+	if config.ReplacePlaceholders {
+		processors = append(processors, PsrLogMessageProcessor())
+	}
+
+	for _, name := range config.Processors {
+		processor, exists := GetProcessor(name)
+		if !exists {
+			return nil, fmt.Errorf("processor [%s] is not registered", name)
+		}
+		processors = append(processors, processor)
+	}
+
+	return append(processors, config.Tap...), nil
+}
+
+// mergeMemberSettings adds the placeholder, processor and caller settings of a
+// stack or fallback channel's members to its own, as Laravel merges member
+// processors into stacks, so they apply to every entry the channel writes.
+// Config.Validate guarantees members do not reference each other in a cycle.
+func mergeMemberSettings(cfg *Config, config ChannelConfig) ChannelConfig {
+	if !isComposite(config.Driver) || config.StackConfig == nil {
+		return config
+	}
+
+	for _, chName := range config.StackConfig.Channels {
+		member, exists := cfg.Channels[chName]
+		if !exists {
+			continue
+		}
+		member = mergeMemberSettings(cfg, member)
+
+		config.ReplacePlaceholders = config.ReplacePlaceholders || member.ReplacePlaceholders
+		for _, name := range member.Processors {
+			if !slices.Contains(config.Processors, name) {
+				config.Processors = append(config.Processors[:len(config.Processors):len(config.Processors)], name)
+			}
+		}
+		config.Tap = append(config.Tap[:len(config.Tap):len(config.Tap)], member.Tap...)
+
+		if member.Caller && callerLevel(member) < callerLevel(config) {
+			config.Caller = true
+			config.CallerLevel = member.CallerLevel
+		}
+	}
+	return config
+}
+
+// resolveConfig fills channel settings inherited from the manager configuration
+func resolveConfig(cfg *Config, config ChannelConfig) ChannelConfig {
+	if config.AppName == "" {
+		config.AppName = cfg.AppName
+	}
+	return config
+}
+
 // createStackChannel creates a stack channel that writes to multiple channels
-func (m *Manager) createStackChannel(name string, config ChannelConfig) (*LogChannel, error) {
+func (m *Manager) createStackChannel(cfg *Config, name string, config ChannelConfig) (*LogChannel, error) {
 	if config.StackConfig == nil || len(config.StackConfig.Channels) == 0 {
 		return nil, fmt.Errorf("stack channel [%s] requires channel list", name)
 	}
 
+	stackDriver, err := m.newStackDriver(cfg, config)
+	if err != nil {
+		return nil, err
+	}
+	driver, err := m.wrapAsync(name, stackDriver, config)
+	if err != nil {
+		return nil, err
+	}
+	m.reportAsyncErrors(name, driver)
+
+	return newLogChannel(name, driver, mergeMemberSettings(cfg, config))
+}
+
+// newStackDriver creates the drivers of a stack's channels, including nested
+// stacks. Config.Validate guarantees stacks do not reference each other in a cycle.
+func (m *Manager) newStackDriver(cfg *Config, config ChannelConfig) (*StackDriver, error) {
 	var drivers []Driver
 	for _, chName := range config.StackConfig.Channels {
-		chConfig, exists := m.config.Channels[chName]
+		chConfig, exists := cfg.Channels[chName]
 		if !exists {
 			return nil, fmt.Errorf("channel [%s] in stack is not defined", chName)
 		}
 
-		factory, exists := GetDriverFactory(chConfig.Driver)
-		if !exists {
-			return nil, fmt.Errorf("driver [%s] is not supported", chConfig.Driver)
-		}
-
-		driver, err := factory(chConfig)
+		driver, err := m.newMemberDriver(cfg, chName, chConfig)
 		if err != nil {
 			if !config.StackConfig.IgnoreExceptions {
+				for _, d := range drivers {
+					d.Close()
+				}
 				return nil, fmt.Errorf("failed to create driver [%s]: %w", chConfig.Driver, err)
 			}
 			continue
@@ -121,22 +300,35 @@ func (m *Manager) createStackChannel(name string, config ChannelConfig) (*LogCha
 		drivers = append(drivers, driver)
 	}
 
-	stackDriver := &StackDriver{
+	return &StackDriver{
 		drivers:          drivers,
 		ignoreExceptions: config.StackConfig.IgnoreExceptions,
-	}
+	}, nil
+}
 
-	level := ParseLevel(config.Level)
-	if config.Level == "" {
-		level = DebugLevel
+// newMemberDriver creates the driver of the named channel used inside a stack
+// or fallback channel, which may itself be a stack or fallback
+func (m *Manager) newMemberDriver(cfg *Config, name string, config ChannelConfig) (Driver, error) {
+	var driver Driver
+	var err error
+
+	switch {
+	case config.Driver == "stack" && config.StackConfig != nil:
+		driver, err = m.newStackDriver(cfg, config)
+	case config.Driver == "fallback" && config.StackConfig != nil:
+		driver, err = m.newFallbackDriver(cfg, config)
+	default:
+		factory, exists := GetDriverFactory(config.Driver)
+		if !exists {
+			return nil, fmt.Errorf("driver [%s] is not supported", config.Driver)
+		}
+		driver, err = factory(resolveConfig(cfg, config))
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	return &LogChannel{
-		name:   name,
-		driver: stackDriver,
-		level:  level,
-		ctx:    make(map[string]any),
-	}, nil
+	return m.wrapAsync(name, driver, config)
 }
 
 // Default returns the default channel logger
@@ -180,6 +372,72 @@ func (m *Manager) SharedContext() map[string]any {
 	return ctx
 }
 
+// AddProcessor registers processors that run for entries on every channel,
+// before the channel's own processors
+func (m *Manager) AddProcessor(processors ...Processor) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Copy so loggers iterating the previous slice are unaffected
+	m.processors = append(m.processors[:len(m.processors):len(m.processors)], processors...)
+}
+
+// Processors returns the processors registered on the manager
+func (m *Manager) Processors() []Processor {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.processors
+}
+
+// Level returns the current minimum level of a channel
+func (m *Manager) Level(name string) (Level, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if ch, exists := m.channels[name]; exists {
+		return ch.level.Level(), nil
+	}
+
+	config, exists := m.config.Channels[name]
+	if !exists {
+		return InfoLevel, fmt.Errorf("channel [%s] is not defined", name)
+	}
+	return channelLevel(config), nil
+}
+
+// SetLevel changes the minimum level of a channel at runtime. The change
+// applies immediately to every Logger of the channel.
+func (m *Manager) SetLevel(name string, level Level) error {
+	if level < DebugLevel || level > EmergencyLevel {
+		return fmt.Errorf("level [%d] is not valid", level)
+	}
+
+	// The channel is created if needed so the level sticks
+	logger, err := m.Channel(name)
+	if err != nil {
+		return err
+	}
+
+	logger.channel.level.SetLevel(level)
+	return nil
+}
+
+// Levels returns the current minimum level of every configured channel
+func (m *Manager) Levels() map[string]Level {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	levels := make(map[string]Level, len(m.config.Channels))
+	for name, config := range m.config.Channels {
+		if ch, exists := m.channels[name]; exists {
+			levels[name] = ch.level.Level()
+		} else {
+			levels[name] = channelLevel(config)
+		}
+	}
+	return levels
+}
+
 // FlushSharedContext clears the shared context
 func (m *Manager) FlushSharedContext() {
 	m.mu.Lock()
@@ -189,17 +447,25 @@ func (m *Manager) FlushSharedContext() {
 
 // Close closes all channels
 func (m *Manager) Close() error {
+	// Channels draining below must not recreate the fallback channel
+	m.closed.Store(true)
+
 	m.mu.Lock()
-	defer m.mu.Unlock()
+	channels := m.channels
+	m.channels = make(map[string]*LogChannel)
+	m.mu.Unlock()
 
+	// Close outside the manager lock: in-flight writes may need it to report errors
 	var lastErr error
-	for _, ch := range m.channels {
+	for _, ch := range channels {
+		// Wait for in-flight writes before closing
+		ch.mu.Lock()
 		if err := ch.driver.Close(); err != nil {
 			lastErr = err
 		}
+		ch.mu.Unlock()
 	}
 
-	m.channels = make(map[string]*LogChannel)
 	return lastErr
 }
 
@@ -233,6 +499,29 @@ func (d *StackDriver) Close() error {
 	return lastErr
 }
 
+// Reopen reopens the files of all drivers in the stack that support it
+func (d *StackDriver) Reopen() error {
+	var errs []error
+	for _, driver := range d.drivers {
+		if r, ok := driver.(Reopener); ok {
+			if err := r.Reopen(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// SetErrorCallback forwards the callback to drivers in the stack that report
+// asynchronous errors
+func (d *StackDriver) SetErrorCallback(fn func(entry *Entry, err error)) {
+	for _, driver := range d.drivers {
+		if r, ok := driver.(AsyncErrorReporter); ok {
+			r.SetErrorCallback(fn)
+		}
+	}
+}
+
 // Name returns the driver name
 func (d *StackDriver) Name() string {
 	return "stack"
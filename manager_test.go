@@ -3,6 +3,7 @@ package golog
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -276,12 +277,9 @@ func TestManager_StackChannel_NoChannels(t *testing.T) {
 		},
 	}
 
-	manager, _ := NewManager(config)
-	defer manager.Close()
-
-	_, err := manager.Channel("stack")
-	if err == nil {
-		t.Error("Expected error for stack with no channels")
+	_, err := NewManager(config)
+	if err == nil || !strings.Contains(err.Error(), "stack requires channel list") {
+		t.Errorf("Expected error for stack with no channels, got %v", err)
 	}
 }
 
@@ -296,12 +294,9 @@ func TestManager_UnsupportedDriver(t *testing.T) {
 		},
 	}
 
-	manager, _ := NewManager(config)
-	defer manager.Close()
-
-	_, err := manager.Channel("custom")
-	if err == nil {
-		t.Error("Expected error for unsupported driver")
+	_, err := NewManager(config)
+	if err == nil || !strings.Contains(err.Error(), "driver [unsupported] is not supported") {
+		t.Errorf("Expected error for unsupported driver, got %v", err)
 	}
 }
 
@@ -337,3 +332,47 @@ func TestStackDriver(t *testing.T) {
 	}
 }
 
+func TestManager_SetLevel(t *testing.T) {
+	manager, driver := newMockManager(t, levelChannels)
+
+	logger, _ := manager.Channel("test")
+	logger.Debug("dropped")
+
+	if err := manager.SetLevel("test", DebugLevel); err != nil {
+		t.Fatalf("SetLevel failed: %v", err)
+	}
+	logger.Debug("kept")
+
+	// Loggers created before the change see it too
+	if len(driver.entries) != 1 || driver.entries[0].Message != "kept" {
+		t.Errorf("Expected only the entry after SetLevel, got %d entries", len(driver.entries))
+	}
+
+	if level, _ := manager.Level("test"); level != DebugLevel {
+		t.Errorf("Expected DEBUG, got %s", level)
+	}
+}
+
+func TestManager_LevelErrors(t *testing.T) {
+	manager, _ := newMockManager(t, levelChannels)
+
+	if _, err := manager.Level("missing"); err == nil {
+		t.Error("Expected error for undefined channel")
+	}
+	if err := manager.SetLevel("missing", DebugLevel); err == nil {
+		t.Error("Expected error for undefined channel")
+	}
+	if err := manager.SetLevel("test", Level(42)); err == nil {
+		t.Error("Expected error for invalid level")
+	}
+}
+
+func TestManager_Levels(t *testing.T) {
+	manager, _ := newMockManager(t, levelChannels)
+	manager.SetLevel("audit", NoticeLevel)
+
+	levels := manager.Levels()
+	if levels["test"] != WarningLevel || levels["audit"] != NoticeLevel {
+		t.Errorf("Unexpected levels %v", levels)
+	}
+}
@@ -0,0 +1,77 @@
+package golog
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// placeholderPattern matches PSR-3 placeholders such as {user_id} or {user.id}
+var placeholderPattern = regexp.MustCompile(`\{([A-Za-z0-9_.]+)\}`)
+
+// placeholderTimeFormat is the layout used for time values, as in Monolog
+const placeholderTimeFormat = "2006-01-02T15:04:05.000000Z07:00"
+
+// replacePlaceholders interpolates {key} placeholders in the entry message with
+// values from its context, like Monolog's PsrLogMessageProcessor. Dotted keys
+// reach into nested maps; unknown placeholders are left untouched.
+func replacePlaceholders(entry *Entry) *Entry {
+	if !strings.Contains(entry.Message, "{") {
+		return entry
+	}
+
+	entry.Message = placeholderPattern.ReplaceAllStringFunc(entry.Message, func(ph string) string {
+		value, ok := lookupPlaceholder(entry.Context, ph[1:len(ph)-1])
+		if !ok {
+			return ph
+		}
+		return placeholderValue(value)
+	})
+	return entry
+}
+
+// lookupPlaceholder finds key in ctx, following dots into nested maps
+// when the full key is not present
+func lookupPlaceholder(ctx map[string]any, key string) (any, bool) {
+	if v, ok := ctx[key]; ok {
+		return v, true
+	}
+
+	head, rest, found := strings.Cut(key, ".")
+	if !found {
+		return nil, false
+	}
+	nested, ok := ctx[head].(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	return lookupPlaceholder(nested, rest)
+}
+
+// placeholderValue converts a context value to the text inserted in the message
+func placeholderValue(v any) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case []byte:
+		return string(val)
+	case time.Time:
+		return val.Format(placeholderTimeFormat)
+	case error:
+		return val.Error()
+	case fmt.Stringer:
+		return val.String()
+	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return fmt.Sprintf("%v", val)
+	default:
+		// Maps, slices and structs are rendered as compact JSON
+		if b, err := json.Marshal(val); err == nil {
+			return string(b)
+		}
+		return fmt.Sprintf("%v", val)
+	}
+}
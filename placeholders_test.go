@@ -0,0 +1,65 @@
+package golog
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestReplacePlaceholders(t *testing.T) {
+	ts := time.Date(2024, 1, 15, 10, 30, 45, 123456000, time.UTC)
+
+	tests := []struct {
+		name    string
+		message string
+		context map[string]any
+		want    string
+	}{
+		{"string", "User {name} logged in", map[string]any{"name": "Ahmed"}, "User Ahmed logged in"},
+		{"int", "User {user_id}", map[string]any{"user_id": 123}, "User 123"},
+		{"float and bool", "{total} {paid}", map[string]any{"total": 20.9, "paid": true}, "20.9 true"},
+		{"time", "at {at}", map[string]any{"at": ts}, "at 2024-01-15T10:30:45.123456Z"},
+		{"error", "failed: {err}", map[string]any{"err": errors.New("timeout")}, "failed: timeout"},
+		{"nil", "[{value}]", map[string]any{"value": nil}, "[]"},
+		{"map", "got {data}", map[string]any{"data": map[string]any{"a": 1}}, `got {"a":1}`},
+		{"slice", "ids {ids}", map[string]any{"ids": []int{1, 2}}, "ids [1,2]"},
+		{"dotted", "user {user.id}", map[string]any{"user": map[string]any{"id": 7}}, "user 7"},
+		{"dotted flat key", "{a.b}", map[string]any{"a.b": "flat"}, "flat"},
+		{"unknown", "hello {missing}", map[string]any{"name": "x"}, "hello {missing}"},
+		{"invalid name", "{not valid}", map[string]any{"not valid": "x"}, "{not valid}"},
+		{"no braces", "plain", map[string]any{"plain": "x"}, "plain"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := NewEntry(InfoLevel, tt.message).WithContext(tt.context)
+			if got := replacePlaceholders(entry).Message; got != tt.want {
+				t.Errorf("replacePlaceholders() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLogger_ReplacePlaceholders(t *testing.T) {
+	manager, driver := newMockManager(t, map[string]ChannelConfig{
+		"test":  {Driver: "mock"},
+		"psr":   {Driver: "mock", ReplacePlaceholders: true},
+		"plain": {Driver: "mock"},
+	})
+
+	psr, _ := manager.Channel("psr")
+	psr.With("user_id", 123).Info("User {user_id} bought {product}", map[string]any{"product": "book"})
+
+	plain, _ := manager.Channel("plain")
+	plain.Info("User {user_id}", map[string]any{"user_id": 123})
+
+	if len(driver.entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(driver.entries))
+	}
+	if got := driver.entries[0].Message; got != "User 123 bought book" {
+		t.Errorf("Expected interpolated message, got %q", got)
+	}
+	if got := driver.entries[1].Message; got != "User {user_id}" {
+		t.Errorf("Expected placeholders to be kept when disabled, got %q", got)
+	}
+}
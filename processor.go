@@ -0,0 +1,135 @@
+package golog
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/debug"
+)
+
+// Processor enriches or rewrites an entry before it reaches the driver,
+// like Monolog processors. Returning nil discards the entry.
+type Processor func(entry *Entry) *Entry
+
+// Built-in processors, selectable by name in ChannelConfig.Processors
+var processorRegistry = map[string]Processor{
+	"hostname":         HostnameProcessor(),
+	"pid":              PIDProcessor(),
+	"goroutines":       GoroutineProcessor(),
+	"memory":           MemoryProcessor(),
+	"build_info":       BuildInfoProcessor(),
+	"uid":              UIDProcessor(7),
+	"psr_placeholders": PsrLogMessageProcessor(),
+}
+
+// RegisterProcessor registers a processor under a name usable in ChannelConfig.Processors
+func RegisterProcessor(name string, processor Processor) {
+	processorRegistry[name] = processor
+}
+
+// GetProcessor returns the processor registered under name
+func GetProcessor(name string) (Processor, bool) {
+	processor, ok := processorRegistry[name]
+	return processor, ok
+}
+
+// runProcessors applies processors in order, stopping if one discards the entry
+func runProcessors(entry *Entry, processors []Processor) *Entry {
+	for _, p := range processors {
+		if entry = p(entry); entry == nil {
+			return nil
+		}
+	}
+	return entry
+}
+
+// HostnameProcessor adds the machine hostname as extra "hostname"
+func HostnameProcessor() Processor {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return func(entry *Entry) *Entry {
+		return entry.WithExtra("hostname", hostname)
+	}
+}
+
+// PIDProcessor adds the process ID as extra "pid"
+func PIDProcessor() Processor {
+	pid := os.Getpid()
+	return func(entry *Entry) *Entry {
+		return entry.WithExtra("pid", pid)
+	}
+}
+
+// GoroutineProcessor adds the number of running goroutines as extra "goroutines"
+func GoroutineProcessor() Processor {
+	return func(entry *Entry) *Entry {
+		return entry.WithExtra("goroutines", runtime.NumGoroutine())
+	}
+}
+
+// MemoryProcessor adds heap and total memory obtained from the OS, in bytes,
+// as extra "memory_alloc" and "memory_sys". runtime.ReadMemStats briefly stops
+// the world, so prefer it on low-volume channels.
+func MemoryProcessor() Processor {
+	return func(entry *Entry) *Entry {
+		var stats runtime.MemStats
+		runtime.ReadMemStats(&stats)
+		entry.WithExtra("memory_alloc", stats.HeapAlloc)
+		return entry.WithExtra("memory_sys", stats.Sys)
+	}
+}
+
+// BuildInfoProcessor adds the main module version, Go version and VCS
+// revision from runtime/debug.ReadBuildInfo as extra "build"
+func BuildInfoProcessor() Processor {
+	build := make(map[string]any)
+	if info, ok := debug.ReadBuildInfo(); ok {
+		build["go_version"] = info.GoVersion
+		if info.Main.Version != "" {
+			build["version"] = info.Main.Version
+		}
+		for _, s := range info.Settings {
+			switch s.Key {
+			case "vcs.revision":
+				build["revision"] = s.Value
+			case "vcs.time":
+				build["time"] = s.Value
+			case "vcs.modified":
+				build["modified"] = s.Value == "true"
+			}
+		}
+	}
+
+	return func(entry *Entry) *Entry {
+		return entry.WithExtra("build", build)
+	}
+}
+
+// UIDProcessor adds a random hex ID of length characters, fixed for the
+// lifetime of the processor, as extra "uid" (like Monolog's UidProcessor)
+func UIDProcessor(length int) Processor {
+	if length <= 0 || length > 32 {
+		length = 7
+	}
+
+	b := make([]byte, (length+1)/2)
+	if _, err := rand.Read(b); err != nil {
+		// Fall back to the PID so the ID is still stable per process
+		b = []byte(fmt.Sprintf("%0*d", len(b), os.Getpid()))
+	}
+	uid := hex.EncodeToString(b)[:length]
+
+	return func(entry *Entry) *Entry {
+		return entry.WithExtra("uid", uid)
+	}
+}
+
+// PsrLogMessageProcessor interpolates {key} placeholders in the message
+// from the context, see ChannelConfig.ReplacePlaceholders
+func PsrLogMessageProcessor() Processor {
+	return replacePlaceholders
+}
@@ -0,0 +1,172 @@
+package golog
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestBuiltinProcessors(t *testing.T) {
+	hostname, _ := os.Hostname()
+
+	tests := []struct {
+		name  string
+		proc  Processor
+		check func(extra map[string]any) bool
+	}{
+		{"hostname", HostnameProcessor(), func(e map[string]any) bool { return e["hostname"] == hostname }},
+		{"pid", PIDProcessor(), func(e map[string]any) bool { return e["pid"] == os.Getpid() }},
+		{"goroutines", GoroutineProcessor(), func(e map[string]any) bool { return e["goroutines"].(int) > 0 }},
+		{"memory", MemoryProcessor(), func(e map[string]any) bool {
+			return e["memory_alloc"].(uint64) > 0 && e["memory_sys"].(uint64) > 0
+		}},
+		{"build_info", BuildInfoProcessor(), func(e map[string]any) bool {
+			build, ok := e["build"].(map[string]any)
+			return ok && strings.HasPrefix(build["go_version"].(string), "go")
+		}},
+		{"uid", UIDProcessor(7), func(e map[string]any) bool { return len(e["uid"].(string)) == 7 }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := tt.proc(NewEntry(InfoLevel, "test"))
+			if !tt.check(entry.Extra) {
+				t.Errorf("Unexpected extra %v", entry.Extra)
+			}
+		})
+	}
+}
+
+func TestUIDProcessor_StablePerInstance(t *testing.T) {
+	p := UIDProcessor(10)
+	first := p(NewEntry(InfoLevel, "a")).Extra["uid"]
+	second := p(NewEntry(InfoLevel, "b")).Extra["uid"]
+	if first != second {
+		t.Errorf("Expected the same uid for one processor, got %v and %v", first, second)
+	}
+
+	if other := UIDProcessor(10)(NewEntry(InfoLevel, "c")).Extra["uid"]; other == first {
+		t.Error("Expected different processors to generate different uids")
+	}
+}
+
+func TestLogger_Processors(t *testing.T) {
+	RegisterProcessor("tenant", func(entry *Entry) *Entry {
+		return entry.WithExtra("tenant", "acme")
+	})
+	defer delete(processorRegistry, "tenant")
+
+	var order []string
+	manager, driver := newMockManager(t, map[string]ChannelConfig{
+		"test": {
+			Driver:     "mock",
+			Processors: []string{"pid", "tenant"},
+			Tap: []Processor{func(entry *Entry) *Entry {
+				order = append(order, "channel")
+				return entry
+			}},
+		},
+	})
+
+	manager.AddProcessor(func(entry *Entry) *Entry {
+		order = append(order, "manager")
+		return entry
+	})
+
+	logger, _ := manager.Channel("test")
+	logger.Info("hello")
+
+	if len(driver.entries) != 1 {
+		t.Fatalf("Expected 1 entry, got %d", len(driver.entries))
+	}
+	extra := driver.entries[0].Extra
+	if extra["pid"] != os.Getpid() || extra["tenant"] != "acme" {
+		t.Errorf("Unexpected extra %v", extra)
+	}
+	if strings.Join(order, ",") != "manager,channel" {
+		t.Errorf("Expected manager processors before channel processors, got %v", order)
+	}
+}
+
+func TestLogger_MemberProcessors(t *testing.T) {
+	for _, composite := range []string{"stack", "fallback"} {
+		t.Run(composite, func(t *testing.T) {
+			manager, driver := newMockManager(t, map[string]ChannelConfig{
+				"test":   {Driver: composite, StackConfig: &StackConfig{Channels: []string{"member"}}},
+				"member": {Driver: "mock", ReplacePlaceholders: true, Processors: []string{"pid"}, Caller: true},
+			})
+
+			logger, _ := manager.Channel("test")
+			logger.Info("user {id}", map[string]any{"id": 7})
+
+			if len(driver.entries) != 1 {
+				t.Fatalf("Expected 1 entry, got %d", len(driver.entries))
+			}
+			entry := driver.entries[0]
+			if entry.Message != "user 7" || entry.Extra["pid"] != os.Getpid() {
+				t.Errorf("Expected member processors to run, got %q with extra %v", entry.Message, entry.Extra)
+			}
+			if entry.Caller == nil || !strings.HasSuffix(entry.Caller.File, "processor_test.go") {
+				t.Errorf("Expected member caller setting to apply, got %v", entry.Caller)
+			}
+		})
+	}
+}
+
+func TestLogger_ProcessorDiscardsEntry(t *testing.T) {
+	manager, driver := newMockManager(t, map[string]ChannelConfig{"test": {Driver: "mock", Level: "debug"}})
+	manager.AddProcessor(func(entry *Entry) *Entry {
+		if entry.Message == "secret" {
+			return nil
+		}
+		return entry
+	})
+
+	logger, _ := manager.Channel("test")
+	logger.Info("secret")
+	logger.Info("public")
+
+	if len(driver.entries) != 1 || driver.entries[0].Message != "public" {
+		t.Errorf("Expected only the public entry, got %d entries", len(driver.entries))
+	}
+}
+
+func TestConfig_Validate_UnknownProcessor(t *testing.T) {
+	config := &Config{
+		Default: "app",
+		Channels: map[string]ChannelConfig{
+			"app": {Driver: "stdout", Processors: []string{"nope"}},
+		},
+	}
+
+	if err := config.Validate(); err == nil || !strings.Contains(err.Error(), "channel [app]: processor [nope] is not registered") {
+		t.Errorf("Expected unknown processor error, got %v", err)
+	}
+}
+
+func TestFormatters_RenderExtra(t *testing.T) {
+	entry := NewEntry(InfoLevel, "hello")
+	entry.Extra = map[string]any{"pid": 42}
+
+	tests := []struct {
+		name      string
+		formatter Formatter
+		want      string
+	}{
+		{"line", NewLineFormatter(""), "    pid: 42"},
+		{"json", NewJSONFormatter(""), `"extra":{"pid":42}`},
+		{"logfmt", NewLogfmtFormatter(""), "extra.pid=42"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := tt.formatter.Format(entry)
+			if err != nil {
+				t.Fatalf("Format failed: %v", err)
+			}
+			if !strings.Contains(string(out), tt.want) {
+				t.Errorf("Expected %q in %q", tt.want, out)
+			}
+		})
+	}
+}
@@ -0,0 +1,273 @@
+package golog
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/signal"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// Reload atomically switches the manager to a new configuration.
+//
+// Only channels whose configuration changed are rebuilt; a change limited to
+// Level is applied without touching the driver. Existing *Logger handles keep
+// working and write to the rebuilt channels. Replaced drivers are closed once
+// their in-flight writes finish. Channels missing from the new configuration
+// are closed and discard further entries. Channels with Tap processors are
+// always rebuilt, as functions cannot be compared. If the new configuration is invalid
+// or a driver cannot be created, nothing changes and the error is returned.
+// A nil config reloads DefaultConfig, as NewManager does.
+func (m *Manager) Reload(config *Config) error {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	m.reloadMu.Lock()
+	defer m.reloadMu.Unlock()
+
+	m.mu.RLock()
+	old := m.config
+	existing := make([]string, 0, len(m.channels))
+	for name := range m.channels {
+		existing = append(existing, name)
+	}
+	m.mu.RUnlock()
+
+	// Build replacements before touching live channels so failures change nothing
+	rebuilt := make(map[string]*LogChannel)
+	for _, name := range existing {
+		if _, kept := config.Channels[name]; !kept || !channelChanged(old, config, name) {
+			continue
+		}
+
+		ch, err := m.createChannel(config, name)
+		if err != nil {
+			for _, b := range rebuilt {
+				b.driver.Close()
+			}
+			return fmt.Errorf("failed to reload channel [%s]: %w", name, err)
+		}
+		rebuilt[name] = ch
+	}
+
+	var stale, swapped []*LogChannel
+
+	m.mu.Lock()
+	m.config = config
+	m.defaultChannel = config.Default
+	m.setFallback(config.FallbackChannel)
+	for name, ch := range m.channels {
+		newConfig, kept := config.Channels[name]
+		if !kept {
+			stale = append(stale, ch)
+			delete(m.channels, name)
+			continue
+		}
+
+		// Keep levels changed at runtime unless the configured level changed
+		if old.Channels[name].Level != newConfig.Level {
+			ch.level.SetLevel(channelLevel(newConfig))
+		}
+
+		if _, ok := rebuilt[name]; ok {
+			swapped = append(swapped, ch)
+		}
+	}
+	m.mu.Unlock()
+
+	// Swap and close outside the manager lock, as replace waits for in-flight
+	// writes, which may be slow or need the lock to report errors
+	var lastErr error
+	for _, ch := range swapped {
+		b := rebuilt[ch.name]
+		if err := ch.replace(b.driver, b.processors, b.caller.Level()).Close(); err != nil {
+			lastErr = err
+		}
+	}
+	for _, ch := range stale {
+		if err := ch.replace(discardDriver{}, nil, callerDisabled).Close(); err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+// replace swaps in a new driver, processors and caller level, waiting for
+// in-flight writes, and returns the previous driver for closing
+func (c *LogChannel) replace(driver Driver, processors []Processor, caller Level) Driver {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	old := c.driver
+	c.driver = driver
+	c.processors = processors
+	c.caller.SetLevel(caller)
+	return old
+}
+
+// channelChanged reports whether a channel must be rebuilt, i.e. its
+// configuration differs in more than the level, or it is a stack including a
+// channel that changed
+func channelChanged(old, updated *Config, name string) bool {
+	return channelChangedVisit(old, updated, name, make(map[string]bool))
+}
+
+// channelChangedVisit implements channelChanged, tracking visited stacks
+func channelChangedVisit(old, updated *Config, name string, seen map[string]bool) bool {
+	if seen[name] {
+		return false
+	}
+	seen[name] = true
+
+	before, existed := old.Channels[name]
+	after := updated.Channels[name]
+	if !existed {
+		return true
+	}
+
+	// AppName is inherited by drivers, so a global change affects every channel
+	if resolveConfig(old, before).AppName != resolveConfig(updated, after).AppName {
+		return true
+	}
+
+	before.Level, after.Level = "", ""
+	if !reflect.DeepEqual(before, after) {
+		return true
+	}
+
+	for _, member := range memberChannels(after) {
+		if channelChangedVisit(old, updated, member, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+// discardDriver drops every entry; channels removed by Reload use it
+type discardDriver struct{}
+
+// Log discards the entry
+func (discardDriver) Log(*Entry) error { return nil }
+
+// Close does nothing
+func (discardDriver) Close() error { return nil }
+
+// Name returns the driver name
+func (discardDriver) Name() string { return "discard" }
+
+// WatchConfigFile polls a JSON configuration file (see LoadConfig) every
+// interval and reloads the manager when its content changes. Load and reload
+// errors are passed to onError, if set, and the current configuration is kept.
+// Call the returned function to stop watching.
+func (m *Manager) WatchConfigFile(path string, interval time.Duration, onError func(error)) (stop func()) {
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+
+	last, _ := os.ReadFile(path)
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+
+			content, err := os.ReadFile(path)
+			if err != nil {
+				reportError(onError, fmt.Errorf("failed to read log config: %w", err))
+				continue
+			}
+			if bytes.Equal(content, last) {
+				continue
+			}
+			last = content
+
+			if err := m.ReloadFile(path); err != nil {
+				reportError(onError, err)
+			}
+		}
+	}()
+
+	return stopOnce(done, &wg)
+}
+
+// ReloadOnSignal reloads the manager from a JSON configuration file whenever
+// one of the given signals (typically syscall.SIGHUP) is received. Errors are
+// passed to onError, if set. Call the returned function to stop.
+func (m *Manager) ReloadOnSignal(path string, onError func(error), signals ...os.Signal) (stop func()) {
+	return onSignal(signals, func() {
+		if err := m.ReloadFile(path); err != nil {
+			reportError(onError, err)
+		}
+	})
+}
+
+// ReloadFile loads a JSON configuration file and reloads the manager with it
+func (m *Manager) ReloadFile(path string) error {
+	config, err := LoadConfig(path)
+	if err != nil {
+		return err
+	}
+	return m.Reload(config)
+}
+
+// onSignal runs fn for every received signal until the returned function is called
+func onSignal(signals []os.Signal, fn func()) (stop func()) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, signals...)
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ch:
+				fn()
+			}
+		}
+	}()
+
+	stopFn := stopOnce(done, &wg)
+	return func() {
+		signal.Stop(ch)
+		stopFn()
+	}
+}
+
+// stopOnce returns a function closing done and waiting for wg, safe to call repeatedly
+func stopOnce(done chan struct{}, wg *sync.WaitGroup) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(done)
+			wg.Wait()
+		})
+	}
+}
+
+// reportError passes err to onError when set
+func reportError(onError func(error), err error) {
+	if onError != nil {
+		onError(err)
+	}
+}
@@ -0,0 +1,483 @@
+package golog
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recordingDriver is a concurrency-safe driver that fails writes after Close
+type recordingDriver struct {
+	mu      sync.Mutex
+	target  string
+	entries []string
+	closed  bool
+
+	// writesAfterClose counts Log calls that arrived after Close
+	writesAfterClose int
+}
+
+func (d *recordingDriver) Log(entry *Entry) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		d.writesAfterClose++
+		return fmt.Errorf("write to closed driver %s", d.target)
+	}
+	d.entries = append(d.entries, entry.Message)
+	return nil
+}
+
+func (d *recordingDriver) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.closed = true
+	return nil
+}
+
+func (d *recordingDriver) Name() string { return "recording" }
+
+func (d *recordingDriver) messages() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]string(nil), d.entries...)
+}
+
+// recordingRegistry creates one recordingDriver per driver construction,
+// keyed by FileConfig.Path
+type recordingRegistry struct {
+	mu      sync.Mutex
+	created []*recordingDriver
+}
+
+func (r *recordingRegistry) latest(target string) *recordingDriver {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := len(r.created) - 1; i >= 0; i-- {
+		if r.created[i].target == target {
+			return r.created[i]
+		}
+	}
+	return nil
+}
+
+func (r *recordingRegistry) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.created)
+}
+
+func newRecordingRegistry(t *testing.T) *recordingRegistry {
+	t.Helper()
+
+	r := &recordingRegistry{}
+	RegisterDriver("recording", func(config ChannelConfig) (Driver, error) {
+		d := &recordingDriver{target: config.FileConfig.Path}
+		r.mu.Lock()
+		r.created = append(r.created, d)
+		r.mu.Unlock()
+		return d, nil
+	})
+	t.Cleanup(func() { delete(driverFactories, "recording") })
+	return r
+}
+
+func recordingChannel(target, level string) ChannelConfig {
+	return ChannelConfig{Driver: "recording", Level: level, FileConfig: &FileConfig{Path: target}}
+}
+
+func reloadConfig(channels map[string]ChannelConfig) *Config {
+	return &Config{Default: "app", Channels: channels}
+}
+
+func TestManager_Reload_RebuildsChangedChannels(t *testing.T) {
+	registry := newRecordingRegistry(t)
+
+	manager, err := NewManager(reloadConfig(map[string]ChannelConfig{
+		"app":   recordingChannel("a", "debug"),
+		"audit": recordingChannel("audit", "debug"),
+	}))
+	if err != nil {
+		t.Fatalf("NewManager failed: %v", err)
+	}
+	defer manager.Close()
+
+	app, _ := manager.Channel("app")
+	audit, _ := manager.Channel("audit")
+	app.Info("before")
+
+	err = manager.Reload(reloadConfig(map[string]ChannelConfig{
+		"app":   recordingChannel("b", "debug"),
+		"audit": recordingChannel("audit", "debug"),
+	}))
+	if err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+
+	// The existing handle now writes to the new driver
+	app.Info("after")
+	audit.Info("audit")
+
+	oldApp, newApp := registry.latest("a"), registry.latest("b")
+	if !oldApp.closed {
+		t.Error("Expected the replaced driver to be closed")
+	}
+	if got := oldApp.messages(); len(got) != 1 || got[0] != "before" {
+		t.Errorf("Unexpected entries in old driver: %v", got)
+	}
+	if got := newApp.messages(); len(got) != 1 || got[0] != "after" {
+		t.Errorf("Unexpected entries in new driver: %v", got)
+	}
+
+	// The unchanged channel keeps its driver
+	if registry.count() != 3 {
+		t.Errorf("Expected 3 drivers to be created, got %d", registry.count())
+	}
+	if got := registry.latest("audit").messages(); len(got) != 1 {
+		t.Errorf("Expected unchanged channel to keep working, got %v", got)
+	}
+}
+
+func TestManager_Reload_LevelOnly(t *testing.T) {
+	registry := newRecordingRegistry(t)
+
+	manager, _ := NewManager(reloadConfig(map[string]ChannelConfig{
+		"app":   recordingChannel("a", "error"),
+		"other": recordingChannel("o", "error"),
+	}))
+	defer manager.Close()
+
+	app, _ := manager.Channel("app")
+	other, _ := manager.Channel("other")
+	manager.SetLevel("other", DebugLevel)
+
+	manager.Reload(reloadConfig(map[string]ChannelConfig{
+		"app":   recordingChannel("a", "debug"),
+		"other": recordingChannel("o", "error"),
+	}))
+
+	app.Debug("debug")
+	other.Debug("kept runtime level")
+
+	if registry.count() != 2 {
+		t.Errorf("Expected no driver to be rebuilt for a level change, got %d drivers", registry.count())
+	}
+	if got := registry.latest("a").messages(); len(got) != 1 {
+		t.Errorf("Expected the new level to apply, got %v", got)
+	}
+	if got := registry.latest("o").messages(); len(got) != 1 {
+		t.Errorf("Expected the runtime level to be kept, got %v", got)
+	}
+}
+
+func TestManager_Reload_RemovedChannel(t *testing.T) {
+	registry := newRecordingRegistry(t)
+
+	manager, _ := NewManager(reloadConfig(map[string]ChannelConfig{
+		"app":  recordingChannel("a", "debug"),
+		"gone": recordingChannel("g", "debug"),
+	}))
+	defer manager.Close()
+
+	gone, _ := manager.Channel("gone")
+
+	if err := manager.Reload(reloadConfig(map[string]ChannelConfig{
+		"app": recordingChannel("a", "debug"),
+	})); err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+
+	gone.Info("discarded")
+
+	if !registry.latest("g").closed {
+		t.Error("Expected the removed channel's driver to be closed")
+	}
+	if _, err := manager.Channel("gone"); err == nil {
+		t.Error("Expected removed channel to be undefined")
+	}
+}
+
+func TestManager_Reload_StackMemberChanged(t *testing.T) {
+	registry := newRecordingRegistry(t)
+
+	stack := ChannelConfig{Driver: "stack", StackConfig: &StackConfig{Channels: []string{"member"}}}
+	manager, _ := NewManager(&Config{Default: "stack", Channels: map[string]ChannelConfig{
+		"stack":  stack,
+		"member": recordingChannel("m1", "debug"),
+	}})
+	defer manager.Close()
+
+	logger, _ := manager.Default()
+
+	manager.Reload(&Config{Default: "stack", Channels: map[string]ChannelConfig{
+		"stack":  stack,
+		"member": recordingChannel("m2", "debug"),
+	}})
+	logger.Info("via stack")
+
+	if got := registry.latest("m2"); got == nil || len(got.messages()) != 1 {
+		t.Error("Expected the stack to be rebuilt with the changed member")
+	}
+}
+
+func TestManager_Reload_InvalidConfig(t *testing.T) {
+	registry := newRecordingRegistry(t)
+
+	manager, _ := NewManager(reloadConfig(map[string]ChannelConfig{
+		"app": recordingChannel("a", "debug"),
+	}))
+	defer manager.Close()
+
+	logger, _ := manager.Channel("app")
+
+	err := manager.Reload(reloadConfig(map[string]ChannelConfig{
+		"app": {Driver: "kafka"},
+	}))
+	if err == nil {
+		t.Fatal("Expected invalid config to be rejected")
+	}
+
+	logger.Info("still here")
+	if got := registry.latest("a").messages(); len(got) != 1 {
+		t.Errorf("Expected the channel to be unchanged, got %v", got)
+	}
+}
+
+func TestManager_Reload_Nil(t *testing.T) {
+	newRecordingRegistry(t)
+
+	manager, _ := NewManager(reloadConfig(map[string]ChannelConfig{
+		"app": recordingChannel("a", "debug"),
+	}))
+	defer manager.Close()
+
+	if err := manager.Reload(nil); err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+	if _, exists := manager.Levels()["file"]; !exists {
+		t.Errorf("Expected the default configuration, got channels %v", manager.Levels())
+	}
+}
+
+func TestManager_Reload_FileRotationSettings(t *testing.T) {
+	tempDir := t.TempDir()
+	appPath := filepath.Join(tempDir, "app.log")
+	otherPath := filepath.Join(tempDir, "other.log")
+
+	manager, err := NewManager(reloadConfig(map[string]ChannelConfig{
+		"app":   NewFileChannelConfig(appPath),
+		"other": NewFileChannelConfig(otherPath),
+	}))
+	if err != nil {
+		t.Fatalf("NewManager failed: %v", err)
+	}
+	defer manager.Close()
+
+	manager.Channel("app")
+	manager.Channel("other")
+	writer := func() *fileWriter {
+		return manager.channels["app"].driver.(*FileDriver).w
+	}
+
+	// A reload failing on another channel leaves the settings unchanged
+	blocker := filepath.Join(tempDir, "blocker")
+	os.WriteFile(blocker, nil, 0644)
+	err = manager.Reload(reloadConfig(map[string]ChannelConfig{
+		"app":   NewFileChannelConfig(appPath, WithFileMaxSize(5)),
+		"other": NewFileChannelConfig(filepath.Join(blocker, "other.log")),
+	}))
+	if err == nil {
+		t.Fatal("Expected reload to fail")
+	}
+	if w := writer(); w.maxSize != 100*megabyte || w.maxBackups != 3 {
+		t.Errorf("Expected unchanged settings after a failed reload, got max size %d and %d backups", w.maxSize, w.maxBackups)
+	}
+
+	err = manager.Reload(reloadConfig(map[string]ChannelConfig{
+		"app":   NewFileChannelConfig(appPath, WithFileMaxSize(1), WithFileMaxBackups(9)),
+		"other": NewFileChannelConfig(otherPath),
+	}))
+	if err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+	if w := writer(); w.maxSize != megabyte || w.maxBackups != 9 {
+		t.Errorf("Expected reloaded settings, got max size %d and %d backups", w.maxSize, w.maxBackups)
+	}
+}
+
+func TestManager_Reload_SlowWrite(t *testing.T) {
+	release := make(chan struct{})
+	RegisterDriver("gated", func(config ChannelConfig) (Driver, error) {
+		d := newGatedDriver()
+		d.release = release
+		return d, nil
+	})
+	t.Cleanup(func() { delete(driverFactories, "gated") })
+
+	manager, _ := NewManager(reloadConfig(map[string]ChannelConfig{
+		"app":   {Driver: "gated", Level: "debug", AppName: "slow"},
+		"other": {Driver: "stderr"},
+	}))
+	defer manager.Close()
+
+	// Hold a write in flight so the reload waits to swap the driver
+	logger, _ := manager.Channel("app")
+	writing := make(chan struct{})
+	go func() {
+		defer close(writing)
+		logger.Info("slow")
+	}()
+	manager.mu.RLock()
+	gated := manager.channels["app"].driver.(*gatedDriver)
+	manager.mu.RUnlock()
+	<-gated.started
+
+	reloaded := make(chan error)
+	go func() {
+		reloaded <- manager.Reload(reloadConfig(map[string]ChannelConfig{
+			"app":   {Driver: "gated", Level: "debug", AppName: "changed"},
+			"other": {Driver: "stderr"},
+		}))
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	got := make(chan error)
+	go func() {
+		_, err := manager.Channel("other")
+		got <- err
+	}()
+	select {
+	case err := <-got:
+		if err != nil {
+			t.Errorf("Channel failed: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Error("Expected Channel not to wait for the reload's in-flight write")
+	}
+
+	close(release)
+	<-writing
+	if err := <-reloaded; err != nil {
+		t.Errorf("Reload failed: %v", err)
+	}
+}
+
+func TestManager_Reload_Concurrent(t *testing.T) {
+	registry := newRecordingRegistry(t)
+
+	manager, _ := NewManager(reloadConfig(map[string]ChannelConfig{
+		"app": recordingChannel("t0", "debug"),
+	}))
+	defer manager.Close()
+
+	logger, _ := manager.Channel("app")
+
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+					logger.Info("msg")
+				}
+			}
+		}()
+	}
+
+	for i := 1; i <= 20; i++ {
+		manager.Reload(reloadConfig(map[string]ChannelConfig{
+			"app": recordingChannel(fmt.Sprintf("t%d", i), "debug"),
+		}))
+	}
+	close(stop)
+	wg.Wait()
+
+	// Every driver but the live one was closed, and none saw a write after Close
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	for _, d := range registry.created[:len(registry.created)-1] {
+		if !d.closed {
+			t.Errorf("Expected driver %s to be closed", d.target)
+		}
+		if d.writesAfterClose > 0 {
+			t.Errorf("Driver %s received %d writes after Close", d.target, d.writesAfterClose)
+		}
+	}
+}
+
+func TestManager_WatchConfigFile(t *testing.T) {
+	newRecordingRegistry(t)
+
+	path := filepath.Join(t.TempDir(), "logging.json")
+	write := func(level string) {
+		content := `{"default": "app", "channels": {"app": {"driver": "recording", "level": "` + level + `", "path": "a"}}}`
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("error")
+
+	config, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+	manager, _ := NewManager(config)
+	defer manager.Close()
+
+	var mu sync.Mutex
+	var errs []error
+	stop := manager.WatchConfigFile(path, 10*time.Millisecond, func(err error) {
+		mu.Lock()
+		errs = append(errs, err)
+		mu.Unlock()
+	})
+	defer stop()
+
+	write("debug")
+	waitFor(t, func() bool {
+		level, _ := manager.Level("app")
+		return level == DebugLevel
+	})
+
+	// Invalid content is reported and ignored
+	os.WriteFile(path, []byte(`{`), 0644)
+	waitFor(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(errs) > 0
+	})
+	if level, _ := manager.Level("app"); level != DebugLevel {
+		t.Errorf("Expected configuration to be kept after a bad reload, got %s", level)
+	}
+
+	mu.Lock()
+	if !strings.Contains(errs[0].Error(), "failed to parse log config") {
+		t.Errorf("Unexpected error %v", errs[0])
+	}
+	mu.Unlock()
+
+	stop()
+	stop()
+}
+
+// waitFor polls cond until it holds or the test times out
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("Timed out waiting for condition")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
@@ -0,0 +1,54 @@
+package golog
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// ReopenFiles reopens the files of every channel created so far whose driver
+// implements Reopener (file, daily and stacks containing them). Call it after
+// an external logrotate with `create` has moved the files away.
+func (m *Manager) ReopenFiles() error {
+	m.mu.RLock()
+	channels := make([]*LogChannel, 0, len(m.channels))
+	for _, ch := range m.channels {
+		channels = append(channels, ch)
+	}
+	m.mu.RUnlock()
+
+	sort.Slice(channels, func(i, j int) bool {
+		return channels[i].name < channels[j].name
+	})
+
+	var errs []error
+	for _, ch := range channels {
+		if err := ch.reopen(); err != nil {
+			errs = append(errs, fmt.Errorf("channel [%s]: %w", ch.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// reopen reopens the channel's driver if it supports it
+func (c *LogChannel) reopen() error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if r, ok := c.driver.(Reopener); ok {
+		return r.Reopen()
+	}
+	return nil
+}
+
+// ReopenOnSignal calls ReopenFiles whenever one of the given signals
+// (typically syscall.SIGHUP or syscall.SIGUSR1) is received. Errors are passed
+// to onError, if set. Call the returned function to stop.
+func (m *Manager) ReopenOnSignal(onError func(error), signals ...os.Signal) (stop func()) {
+	return onSignal(signals, func() {
+		if err := m.ReopenFiles(); err != nil {
+			reportError(onError, err)
+		}
+	})
+}
@@ -0,0 +1,85 @@
+package golog
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestManager_ReopenFiles(t *testing.T) {
+	tempDir := t.TempDir()
+	filePath := filepath.Join(tempDir, "app.log")
+	dailyPath := filepath.Join(tempDir, "daily.log")
+
+	manager, err := NewManager(&Config{
+		Default: "stack",
+		Channels: map[string]ChannelConfig{
+			"stack":  {Driver: "stack", StackConfig: &StackConfig{Channels: []string{"file", "stdout"}}},
+			"file":   NewFileChannelConfig(filePath),
+			"daily":  NewDailyChannelConfig(dailyPath, 7),
+			"stdout": NewStdoutChannelConfig(),
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewManager failed: %v", err)
+	}
+	defer manager.Close()
+
+	stack, _ := manager.Default()
+	daily, _ := manager.Channel("daily")
+	stack.Info("first")
+	daily.Info("first")
+
+	dailyFiles, _ := filepath.Glob(filepath.Join(tempDir, "daily-*.log"))
+	if len(dailyFiles) != 1 {
+		t.Fatalf("Expected one daily file, got %v", dailyFiles)
+	}
+	os.Rename(filePath, filePath+".1")
+	os.Rename(dailyFiles[0], dailyFiles[0]+".1")
+
+	if err := manager.ReopenFiles(); err != nil {
+		t.Fatalf("ReopenFiles failed: %v", err)
+	}
+
+	stack.Info("second")
+	daily.Info("second")
+
+	for _, path := range []string{filePath, dailyFiles[0]} {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("Expected %s to be recreated: %v", path, err)
+		}
+		if !strings.Contains(string(content), "second") || strings.Contains(string(content), "first") {
+			t.Errorf("Unexpected content in %s: %q", path, content)
+		}
+	}
+}
+
+func TestManager_ReopenOnSignal(t *testing.T) {
+	tempDir := t.TempDir()
+	logPath := filepath.Join(tempDir, "app.log")
+
+	manager, _ := NewManager(&Config{
+		Default:  "file",
+		Channels: map[string]ChannelConfig{"file": NewFileChannelConfig(logPath)},
+	})
+	defer manager.Close()
+
+	logger, _ := manager.Default()
+	logger.Info("first")
+	os.Rename(logPath, logPath+".1")
+
+	stop := manager.ReopenOnSignal(nil, os.Interrupt)
+	defer stop()
+
+	p, _ := os.FindProcess(os.Getpid())
+	if err := p.Signal(os.Interrupt); err != nil {
+		t.Skipf("Sending signals is not supported: %v", err)
+	}
+
+	waitFor(t, func() bool {
+		_, err := os.Stat(logPath)
+		return err == nil
+	})
+}
@@ -18,6 +18,9 @@ type SlackDriver struct {
 	timeout    time.Duration
 	async      bool
 	client     *http.Client
+
+	// onError receives errors from asynchronous sends
+	onError func(entry *Entry, err error)
 }
 
 // SlackMessage represents a Slack message payload
@@ -94,7 +97,9 @@ func (d *SlackDriver) Log(entry *Entry) error {
 
 	if d.async {
 		go func() {
-			_ = d.send(msg)
+			if err := d.send(msg); err != nil && d.onError != nil {
+				d.onError(entry, err)
+			}
 		}()
 		return nil
 	}
@@ -150,6 +155,25 @@ func (d *SlackDriver) buildMessage(entry *Entry) *SlackMessage {
 		})
 	}
 
+	// Add extra data from processors
+	for key, value := range entry.Extra {
+		fieldValue := formatSlackValue(value)
+		attachment.Fields = append(attachment.Fields, SlackField{
+			Title: formatFieldTitle(key),
+			Value: fieldValue,
+			Short: len(fieldValue) < 40,
+		})
+	}
+
+	// Add caller if captured
+	if entry.Caller != nil {
+		attachment.Fields = append(attachment.Fields, SlackField{
+			Title: "Caller",
+			Value: fmt.Sprintf("`%s`", entry.Caller),
+			Short: false,
+		})
+	}
+
 	// Add exception information if present
 	if entry.Exception != nil {
 		exceptionJSON := entry.ExceptionJSON()
@@ -256,6 +280,11 @@ func (d *SlackDriver) send(msg *SlackMessage) error {
 	return nil
 }
 
+// SetErrorCallback sets the function receiving errors from asynchronous sends
+func (d *SlackDriver) SetErrorCallback(fn func(entry *Entry, err error)) {
+	d.onError = fn
+}
+
 // Close closes the driver
 func (d *SlackDriver) Close() error {
 	return nil
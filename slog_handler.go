@@ -0,0 +1,193 @@
+package golog
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Extra slog levels for golog severities that slog does not define
+const (
+	SlogLevelNotice    = slog.Level(2)
+	SlogLevelCritical  = slog.Level(12)
+	SlogLevelAlert     = slog.Level(16)
+	SlogLevelEmergency = slog.Level(20)
+)
+
+// SlogHandler is a log/slog Handler that writes records to a golog Logger,
+// so libraries using slog end up in golog channels
+type SlogHandler struct {
+	logger *Logger
+	groups []string
+}
+
+// NewSlogHandler creates an slog handler writing to logger
+func NewSlogHandler(logger *Logger) *SlogHandler {
+	return &SlogHandler{logger: logger}
+}
+
+// SlogHandler returns an slog handler writing to the named channel
+func (m *Manager) SlogHandler(channel string) (*SlogHandler, error) {
+	logger, err := m.Channel(channel)
+	if err != nil {
+		return nil, err
+	}
+	return NewSlogHandler(logger), nil
+}
+
+// LevelFromSlog maps an slog level to the closest golog level
+func LevelFromSlog(level slog.Level) Level {
+	switch {
+	case level < slog.LevelInfo:
+		return DebugLevel
+	case level < SlogLevelNotice:
+		return InfoLevel
+	case level < slog.LevelWarn:
+		return NoticeLevel
+	case level < slog.LevelError:
+		return WarningLevel
+	case level < SlogLevelCritical:
+		return ErrorLevel
+	case level < SlogLevelAlert:
+		return CriticalLevel
+	case level < SlogLevelEmergency:
+		return AlertLevel
+	default:
+		return EmergencyLevel
+	}
+}
+
+// Enabled reports whether the channel accepts records at level
+func (h *SlogHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return h.logger.enabled(LevelFromSlog(level))
+}
+
+// Handle writes the record to the channel, with attributes as context
+func (h *SlogHandler) Handle(ctx context.Context, r slog.Record) error {
+	fields := make(map[string]any, r.NumAttrs())
+	r.Attrs(func(a slog.Attr) bool {
+		addSlogAttr(fields, a)
+		return true
+	})
+
+	level := LevelFromSlog(r.Level)
+	if !h.logger.enabled(level) {
+		return nil
+	}
+
+	entry := NewEntry(level, r.Message)
+	if !r.Time.IsZero() {
+		entry.Timestamp = r.Time
+	}
+	if r.PC != 0 && h.logger.capturesCaller(level) {
+		entry.Caller = callerFromPC(r.PC)
+	}
+
+	data := FieldsFromContext(ctx)
+	if len(fields) > 0 {
+		data = mergeContext(data, h.mergeWithLogger(nestInGroups(h.groups, fields)))
+	}
+
+	h.logger.write(entry, data)
+	return nil
+}
+
+// WithAttrs returns a handler whose logger carries attrs in its context
+func (h *SlogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	fields := make(map[string]any, len(attrs))
+	for _, a := range attrs {
+		addSlogAttr(fields, a)
+	}
+	if len(fields) == 0 {
+		return h
+	}
+
+	return &SlogHandler{
+		logger: h.logger.WithContext(h.mergeWithLogger(nestInGroups(h.groups, fields))),
+		groups: h.groups,
+	}
+}
+
+// WithGroup returns a handler that nests subsequent attributes under name
+func (h *SlogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	groups := make([]string, len(h.groups), len(h.groups)+1)
+	copy(groups, h.groups)
+
+	return &SlogHandler{
+		logger: h.logger,
+		groups: append(groups, name),
+	}
+}
+
+// mergeWithLogger deep-merges fields into the logger context for keys present
+// in both, so attributes added at different times share their groups
+func (h *SlogHandler) mergeWithLogger(fields map[string]any) map[string]any {
+	h.logger.mu.RLock()
+	defer h.logger.mu.RUnlock()
+
+	for k, v := range fields {
+		existing, ok := h.logger.ctx[k].(map[string]any)
+		group, isGroup := v.(map[string]any)
+		if ok && isGroup {
+			fields[k] = deepMerge(existing, group)
+		}
+	}
+	return fields
+}
+
+// addSlogAttr stores the resolved attribute in fields; groups become nested maps
+func addSlogAttr(fields map[string]any, a slog.Attr) {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return
+	}
+
+	if a.Value.Kind() != slog.KindGroup {
+		fields[a.Key] = a.Value.Any()
+		return
+	}
+
+	group := fields
+	if a.Key != "" {
+		nested, ok := fields[a.Key].(map[string]any)
+		if !ok {
+			nested = make(map[string]any)
+		}
+		group = nested
+	}
+	for _, ga := range a.Value.Group() {
+		addSlogAttr(group, ga)
+	}
+	if a.Key != "" && len(group) > 0 {
+		fields[a.Key] = group
+	}
+}
+
+// nestInGroups wraps fields in one nested map per open group
+func nestInGroups(groups []string, fields map[string]any) map[string]any {
+	for i := len(groups) - 1; i >= 0; i-- {
+		fields = map[string]any{groups[i]: fields}
+	}
+	return fields
+}
+
+// deepMerge returns a copy of dst with src merged in, recursing into nested maps
+func deepMerge(dst, src map[string]any) map[string]any {
+	result := make(map[string]any, len(dst)+len(src))
+	for k, v := range dst {
+		result[k] = v
+	}
+	for k, v := range src {
+		existing, ok := result[k].(map[string]any)
+		nested, isMap := v.(map[string]any)
+		if ok && isMap {
+			result[k] = deepMerge(existing, nested)
+			continue
+		}
+		result[k] = v
+	}
+	return result
+}
@@ -0,0 +1,122 @@
+package golog
+
+import (
+	"context"
+	"log/slog"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLevelFromSlog(t *testing.T) {
+	tests := []struct {
+		level    slog.Level
+		expected Level
+	}{
+		{slog.LevelDebug, DebugLevel},
+		{slog.LevelDebug + 2, DebugLevel},
+		{slog.LevelInfo, InfoLevel},
+		{SlogLevelNotice, NoticeLevel},
+		{slog.LevelWarn, WarningLevel},
+		{slog.LevelError, ErrorLevel},
+		{SlogLevelCritical, CriticalLevel},
+		{SlogLevelAlert, AlertLevel},
+		{SlogLevelEmergency, EmergencyLevel},
+		{slog.Level(100), EmergencyLevel},
+	}
+
+	for _, tt := range tests {
+		if got := LevelFromSlog(tt.level); got != tt.expected {
+			t.Errorf("LevelFromSlog(%v) = %s, want %s", tt.level, got, tt.expected)
+		}
+	}
+}
+
+func TestSlogHandler_Enabled(t *testing.T) {
+	manager, _ := newMockManager(t, map[string]ChannelConfig{"test": {Driver: "mock", Level: "warning"}})
+
+	handler, err := manager.SlogHandler("test")
+	if err != nil {
+		t.Fatalf("SlogHandler failed: %v", err)
+	}
+
+	if handler.Enabled(context.Background(), slog.LevelInfo) {
+		t.Error("Expected info to be disabled for a warning channel")
+	}
+	if !handler.Enabled(context.Background(), slog.LevelError) {
+		t.Error("Expected error to be enabled for a warning channel")
+	}
+}
+
+func TestSlogHandler_Handle(t *testing.T) {
+	manager, driver := newMockManager(t, map[string]ChannelConfig{"test": {Driver: "mock", Level: "debug"}})
+	handler, _ := manager.SlogHandler("test")
+
+	logger := slog.New(handler).With("service", "api").WithGroup("http").With("method", "GET")
+	ctx := WithFields(context.Background(), map[string]any{"request_id": "r-1"})
+	logger.WarnContext(ctx, "slow request", "status", 200, slog.Group("timing", "total", time.Second))
+
+	if len(driver.entries) != 1 {
+		t.Fatalf("Expected 1 entry, got %d", len(driver.entries))
+	}
+
+	entry := driver.entries[0]
+	if entry.Level != WarningLevel || entry.Message != "slow request" || entry.Channel != "test" {
+		t.Errorf("Unexpected entry %+v", entry)
+	}
+	if entry.Context["service"] != "api" || entry.Context["request_id"] != "r-1" {
+		t.Errorf("Expected top-level attrs and context fields, got %v", entry.Context)
+	}
+
+	http, ok := entry.Context["http"].(map[string]any)
+	if !ok {
+		t.Fatalf("Expected http group, got %v", entry.Context)
+	}
+	if http["method"] != "GET" || http["status"] != int64(200) {
+		t.Errorf("Expected group attrs to be merged, got %v", http)
+	}
+	timing, _ := http["timing"].(map[string]any)
+	if timing["total"] != time.Second {
+		t.Errorf("Expected nested group, got %v", http["timing"])
+	}
+}
+
+func TestSlogHandler_EmptyGroupOmitted(t *testing.T) {
+	manager, driver := newMockManager(t, map[string]ChannelConfig{"test": {Driver: "mock", Level: "debug"}})
+	handler, _ := manager.SlogHandler("test")
+
+	slog.New(handler).WithGroup("empty").Info("no attrs")
+
+	if len(driver.entries) != 1 {
+		t.Fatalf("Expected 1 entry, got %d", len(driver.entries))
+	}
+	if _, ok := driver.entries[0].Context["empty"]; ok {
+		t.Error("Expected empty group to be omitted")
+	}
+}
+
+func TestSlogHandler_ChannelNotFound(t *testing.T) {
+	manager, _ := NewManager(&Config{
+		Default: "file",
+		Channels: map[string]ChannelConfig{
+			"file": NewFileChannelConfig(filepath.Join(t.TempDir(), "test.log")),
+		},
+	})
+	defer manager.Close()
+
+	if _, err := manager.SlogHandler("missing"); err == nil {
+		t.Error("Expected error for missing channel")
+	}
+}
+
+func TestSlogHandler_RecordTime(t *testing.T) {
+	manager, driver := newMockManager(t, map[string]ChannelConfig{"test": {Driver: "mock", Level: "debug"}})
+	handler, _ := manager.SlogHandler("test")
+
+	ts := time.Date(2024, 1, 15, 10, 30, 45, 0, time.UTC)
+	handler.Handle(context.Background(), slog.NewRecord(ts, slog.LevelInfo, "at time", 0))
+
+	if len(driver.entries) != 1 || !driver.entries[0].Timestamp.Equal(ts) {
+		t.Errorf("Expected record time to be used, got %+v", driver.entries)
+	}
+}
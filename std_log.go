@@ -0,0 +1,80 @@
+package golog
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"log/slog"
+	"strings"
+	"sync"
+)
+
+// lineWriter is an io.Writer that logs every complete line it receives
+type lineWriter struct {
+	mu     sync.Mutex
+	logger *Logger
+	level  Level
+	buf    []byte
+}
+
+// Writer returns an io.Writer that logs each written line as an entry at level.
+// Incomplete lines are kept until their newline arrives.
+func (l *Logger) Writer(level Level) io.Writer {
+	return &lineWriter{logger: l, level: level}
+}
+
+// Write logs every complete line in p
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+		line := strings.TrimRight(string(w.buf[:i]), "\r")
+		w.buf = w.buf[i+1:]
+
+		if strings.TrimSpace(line) != "" {
+			w.logger.log(w.level, line, nil)
+		}
+	}
+
+	// Release the backing array once everything has been consumed
+	if len(w.buf) == 0 {
+		w.buf = nil
+	}
+	return len(p), nil
+}
+
+// StdLogger returns a *log.Logger that writes to this logger at level,
+// e.g. for http.Server.ErrorLog
+func (l *Logger) StdLogger(level Level) *log.Logger {
+	return log.New(l.Writer(level), "", 0)
+}
+
+// Slog returns an *slog.Logger that writes to this logger
+func (l *Logger) Slog() *slog.Logger {
+	return slog.New(NewSlogHandler(l))
+}
+
+// RedirectStdLog sends the output of the standard library's global log package
+// to logger at level. golog adds its own timestamps, so log flags are cleared.
+// The returned function restores the previous output, flags and prefix.
+func RedirectStdLog(logger *Logger, level Level) func() {
+	prevOutput := log.Writer()
+	prevFlags := log.Flags()
+	prevPrefix := log.Prefix()
+
+	log.SetOutput(logger.Writer(level))
+	log.SetFlags(0)
+	log.SetPrefix("")
+
+	return func() {
+		log.SetOutput(prevOutput)
+		log.SetFlags(prevFlags)
+		log.SetPrefix(prevPrefix)
+	}
+}
@@ -0,0 +1,91 @@
+package golog
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+)
+
+func TestLogger_Writer(t *testing.T) {
+	manager, driver := newMockManager(t, map[string]ChannelConfig{"test": {Driver: "mock", Level: "debug"}})
+	logger, _ := manager.Channel("test")
+
+	w := logger.Writer(WarningLevel)
+	fmt.Fprint(w, "first line\r\nsecond ")
+	if len(driver.entries) != 1 {
+		t.Fatalf("Expected only the complete line to be logged, got %d entries", len(driver.entries))
+	}
+
+	fmt.Fprint(w, "line\n\n")
+	if len(driver.entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(driver.entries))
+	}
+
+	if driver.entries[0].Message != "first line" || driver.entries[1].Message != "second line" {
+		t.Errorf("Unexpected messages %q, %q", driver.entries[0].Message, driver.entries[1].Message)
+	}
+	if driver.entries[0].Level != WarningLevel {
+		t.Errorf("Expected warning level, got %s", driver.entries[0].Level)
+	}
+}
+
+func TestLogger_Writer_RespectsLevel(t *testing.T) {
+	manager, driver := newMockManager(t, map[string]ChannelConfig{"test": {Driver: "mock", Level: "error"}})
+	logger, _ := manager.Channel("test")
+
+	fmt.Fprintln(logger.Writer(InfoLevel), "filtered")
+	if len(driver.entries) != 0 {
+		t.Errorf("Expected entry below channel level to be dropped, got %d", len(driver.entries))
+	}
+}
+
+func TestLogger_StdLogger(t *testing.T) {
+	manager, driver := newMockManager(t, map[string]ChannelConfig{"test": {Driver: "mock", Level: "debug"}})
+	logger, _ := manager.Channel("test")
+
+	std := logger.StdLogger(ErrorLevel)
+	std.Printf("http: TLS handshake error from %s", "10.0.0.1")
+
+	if len(driver.entries) != 1 {
+		t.Fatalf("Expected 1 entry, got %d", len(driver.entries))
+	}
+	entry := driver.entries[0]
+	if entry.Level != ErrorLevel || entry.Message != "http: TLS handshake error from 10.0.0.1" {
+		t.Errorf("Unexpected entry %+v", entry)
+	}
+}
+
+func TestLogger_Slog(t *testing.T) {
+	manager, driver := newMockManager(t, map[string]ChannelConfig{"test": {Driver: "mock", Level: "debug"}})
+	logger, _ := manager.Channel("test")
+
+	logger.Slog().InfoContext(context.Background(), "via slog", "key", "value")
+
+	if len(driver.entries) != 1 || driver.entries[0].Context["key"] != "value" {
+		t.Errorf("Expected slog record in channel, got %+v", driver.entries)
+	}
+}
+
+func TestRedirectStdLog(t *testing.T) {
+	manager, driver := newMockManager(t, map[string]ChannelConfig{"test": {Driver: "mock", Level: "debug"}})
+	logger, _ := manager.Channel("test")
+
+	prevOutput := log.Writer()
+	prevFlags := log.Flags()
+
+	restore := RedirectStdLog(logger, NoticeLevel)
+	log.Println("from std log")
+	restore()
+
+	if len(driver.entries) != 1 {
+		t.Fatalf("Expected 1 entry, got %d", len(driver.entries))
+	}
+	if driver.entries[0].Message != "from std log" || driver.entries[0].Level != NoticeLevel {
+		t.Errorf("Unexpected entry %+v", driver.entries[0])
+	}
+
+	if log.Writer() != prevOutput || log.Flags() != prevFlags {
+		t.Error("Expected restore to reset the global logger")
+	}
+}
@@ -0,0 +1,118 @@
+//go:build !windows && !plan9
+
+package golog
+
+import (
+	"fmt"
+	"log/syslog"
+	"strings"
+	"sync"
+)
+
+func init() {
+	driverFactories["syslog"] = NewSyslogDriver
+}
+
+// syslogLineFormat is the default message layout, matching Laravel's syslog channel
+const syslogLineFormat = "%channel%.%level_name%: %message% %context% %extra%"
+
+// SyslogDriver sends log entries to the local syslog daemon or a remote server
+type SyslogDriver struct {
+	mu        sync.Mutex
+	writer    *syslog.Writer
+	formatter Formatter
+}
+
+// NewSyslogDriver creates a new syslog driver from configuration
+func NewSyslogDriver(config ChannelConfig) (Driver, error) {
+	var network, address, facilityName, ident string
+	if config.SyslogConfig != nil {
+		network = config.SyslogConfig.Network
+		address = config.SyslogConfig.Address
+		facilityName = config.SyslogConfig.Facility
+		ident = config.SyslogConfig.Ident
+	}
+
+	facility, err := parseSyslogFacility(facilityName)
+	if err != nil {
+		return nil, err
+	}
+
+	if ident == "" {
+		ident = config.AppName
+	}
+
+	formatter, err := newSyslogFormatter(config)
+	if err != nil {
+		return nil, err
+	}
+
+	// An empty network and address connect to the local syslog socket (/dev/log)
+	writer, err := syslog.Dial(network, address, syslog.Priority(facility<<3)|syslog.LOG_INFO, ident)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to syslog: %w", err)
+	}
+
+	return &SyslogDriver{
+		writer:    writer,
+		formatter: formatter,
+	}, nil
+}
+
+// newSyslogFormatter returns the configured formatter, defaulting to a
+// single-line layout since syslog records cannot span lines
+func newSyslogFormatter(config ChannelConfig) (Formatter, error) {
+	if config.Formatter == "" && config.FormatterConfig == nil {
+		return &LineFormatter{
+			Template:                   syslogLineFormat,
+			IgnoreEmptyContextAndExtra: true,
+		}, nil
+	}
+	return NewFormatter(config)
+}
+
+// Log sends a log entry to syslog at the severity matching its level
+func (d *SyslogDriver) Log(entry *Entry) error {
+	formatted, err := d.formatter.Format(entry)
+	if err != nil {
+		return fmt.Errorf("failed to format log entry: %w", err)
+	}
+	msg := strings.TrimRight(string(formatted), "\n")
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	// golog's levels are exactly the RFC 5424 severities
+	switch entry.Level {
+	case DebugLevel:
+		return d.writer.Debug(msg)
+	case InfoLevel:
+		return d.writer.Info(msg)
+	case NoticeLevel:
+		return d.writer.Notice(msg)
+	case WarningLevel:
+		return d.writer.Warning(msg)
+	case ErrorLevel:
+		return d.writer.Err(msg)
+	case CriticalLevel:
+		return d.writer.Crit(msg)
+	case AlertLevel:
+		return d.writer.Alert(msg)
+	case EmergencyLevel:
+		return d.writer.Emerg(msg)
+	default:
+		return d.writer.Info(msg)
+	}
+}
+
+// Close closes the connection to syslog
+func (d *SyslogDriver) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.writer.Close()
+}
+
+// Name returns the driver name
+func (d *SyslogDriver) Name() string {
+	return "syslog"
+}
@@ -0,0 +1,130 @@
+//go:build !windows && !plan9
+
+package golog
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+func listenSyslogUDP(t *testing.T) *net.UDPConn {
+	t.Helper()
+
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatalf("ListenUDP failed: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func readSyslogPacket(t *testing.T, conn *net.UDPConn) string {
+	t.Helper()
+
+	buf := make([]byte, 4096)
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	n, _, err := conn.ReadFromUDP(buf)
+	if err != nil {
+		t.Fatalf("ReadFromUDP failed: %v", err)
+	}
+	return string(buf[:n])
+}
+
+func TestNewSyslogDriver_UnknownFacility(t *testing.T) {
+	config := NewSyslogChannelConfig("udp", "127.0.0.1:514", WithSyslogFacility("nope"))
+
+	if _, err := NewSyslogDriver(config); err == nil {
+		t.Error("Expected error for unknown facility")
+	}
+}
+
+func TestSyslogDriver_Log(t *testing.T) {
+	conn := listenSyslogUDP(t)
+
+	config := NewSyslogChannelConfig("udp", conn.LocalAddr().String(), WithSyslogFacility("local0"))
+	config.AppName = "ShopApp"
+
+	driver, err := NewSyslogDriver(config)
+	if err != nil {
+		t.Fatalf("NewSyslogDriver failed: %v", err)
+	}
+	defer driver.Close()
+
+	if driver.Name() != "syslog" {
+		t.Errorf("Expected driver name 'syslog', got %q", driver.Name())
+	}
+
+	entry := NewEntry(ErrorLevel, "payment failed")
+	entry.SetChannel("payments")
+	entry.With("order_id", 42)
+	if err := driver.Log(entry); err != nil {
+		t.Fatalf("Log failed: %v", err)
+	}
+
+	packet := readSyslogPacket(t, conn)
+
+	// local0 (16) * 8 + err (3) = 131
+	if !strings.HasPrefix(packet, "<131>") {
+		t.Errorf("Expected priority <131>, got %q", packet)
+	}
+	if !strings.Contains(packet, "ShopApp[") {
+		t.Errorf("Expected ident defaulting to app name, got %q", packet)
+	}
+	if !strings.Contains(packet, `payments.ERROR: payment failed {"order_id":42}`) {
+		t.Errorf("Unexpected message %q", packet)
+	}
+}
+
+func TestSyslogDriver_Severities(t *testing.T) {
+	conn := listenSyslogUDP(t)
+
+	config := NewSyslogChannelConfig("udp", conn.LocalAddr().String(), WithSyslogIdent("test"))
+	driver, err := NewSyslogDriver(config)
+	if err != nil {
+		t.Fatalf("NewSyslogDriver failed: %v", err)
+	}
+	defer driver.Close()
+
+	// user facility (1) * 8 + severity
+	want := map[Level]string{
+		DebugLevel:     "<15>",
+		InfoLevel:      "<14>",
+		NoticeLevel:    "<13>",
+		WarningLevel:   "<12>",
+		ErrorLevel:     "<11>",
+		CriticalLevel:  "<10>",
+		AlertLevel:     "<9>",
+		EmergencyLevel: "<8>",
+	}
+
+	for level := DebugLevel; level <= EmergencyLevel; level++ {
+		if err := driver.Log(NewEntry(level, "message")); err != nil {
+			t.Fatalf("Log failed: %v", err)
+		}
+		packet := readSyslogPacket(t, conn)
+		if !strings.HasPrefix(packet, want[level]) {
+			t.Errorf("%s: expected priority %s, got %q", level, want[level], packet)
+		}
+	}
+}
+
+func TestSyslogDriver_CustomFormatter(t *testing.T) {
+	conn := listenSyslogUDP(t)
+
+	config := NewSyslogChannelConfig("udp", conn.LocalAddr().String())
+	config.Formatter = "logfmt"
+
+	driver, err := NewSyslogDriver(config)
+	if err != nil {
+		t.Fatalf("NewSyslogDriver failed: %v", err)
+	}
+	defer driver.Close()
+
+	driver.Log(NewEntry(InfoLevel, "hello"))
+	packet := readSyslogPacket(t, conn)
+	if !strings.Contains(packet, "level=info") || !strings.Contains(packet, "msg=hello") {
+		t.Errorf("Expected logfmt message, got %q", packet)
+	}
+}
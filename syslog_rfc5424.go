@@ -0,0 +1,500 @@
+package golog
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// syslogFacilityCodes maps facility names to their RFC 5424 numeric codes
+var syslogFacilityCodes = map[string]int{
+	"kern":     0,
+	"user":     1,
+	"mail":     2,
+	"daemon":   3,
+	"auth":     4,
+	"syslog":   5,
+	"lpr":      6,
+	"news":     7,
+	"uucp":     8,
+	"cron":     9,
+	"authpriv": 10,
+	"ftp":      11,
+	"local0":   16,
+	"local1":   17,
+	"local2":   18,
+	"local3":   19,
+	"local4":   20,
+	"local5":   21,
+	"local6":   22,
+	"local7":   23,
+}
+
+// parseSyslogFacility returns the code for a facility name, defaulting to "user"
+func parseSyslogFacility(name string) (int, error) {
+	if name == "" {
+		name = "user"
+	}
+	code, ok := syslogFacilityCodes[strings.ToLower(name)]
+	if !ok {
+		return 0, fmt.Errorf("syslog facility [%s] is not supported", name)
+	}
+	return code, nil
+}
+
+const (
+	// defaultSyslogSDID is the SD-ID used for Entry.Context; 32473 is the
+	// private enterprise number reserved for documentation (RFC 5612)
+	defaultSyslogSDID = "golog@32473"
+
+	// defaultSyslogBufferSize is the number of messages kept while disconnected
+	defaultSyslogBufferSize = 1000
+
+	// syslogReconnectInterval is the delay between failed delivery attempts
+	syslogReconnectInterval = time.Second
+
+	// rfc5424TimeFormat is RFC 3339 limited to microseconds, as RFC 5424 requires
+	rfc5424TimeFormat = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+// Errors reported to the error callback for dropped messages
+var (
+	errSyslogBufferFull  = errors.New("syslog buffer full, dropped oldest message")
+	errSyslogUndelivered = errors.New("syslog message was not delivered before close")
+)
+
+// RFC5424Driver sends RFC 5424 syslog messages to a remote server over TCP
+// (octet-counting framing, optionally TLS) or UDP. Entry.Context is sent as
+// STRUCTURED-DATA. Log only buffers messages; a background goroutine delivers
+// them, reconnecting while the server is unreachable, and reports failed
+// deliveries through SetErrorCallback.
+type RFC5424Driver struct {
+	network    string
+	tlsConfig  *tls.Config
+	timeout    time.Duration
+	facility   int
+	hostname   string
+	appName    string
+	procID     string
+	sdID       string
+	bufferSize int
+
+	// mu guards the buffer, closed and onError
+	mu      sync.Mutex
+	buffer  []*syslogMessage
+	closed  bool
+	onError func(entry *Entry, err error)
+
+	// connMu guards the connection, held while the sender delivers
+	connMu  sync.Mutex
+	address string
+	conn    net.Conn
+
+	wake chan struct{}
+	quit chan struct{}
+	done chan struct{}
+}
+
+// syslogMessage is a formatted message waiting to be delivered
+type syslogMessage struct {
+	entry *Entry
+	data  []byte
+}
+
+// NewRFC5424Driver creates a new RFC 5424 syslog driver from configuration.
+// The server does not need to be reachable yet; messages are buffered until it is.
+func NewRFC5424Driver(config ChannelConfig) (Driver, error) {
+	if config.SyslogConfig == nil || config.SyslogConfig.Address == "" {
+		return nil, fmt.Errorf("syslog address is required")
+	}
+	sc := config.SyslogConfig
+
+	network := sc.Network
+	if network == "" {
+		network = "tcp"
+	}
+	if network != "tcp" && network != "udp" {
+		return nil, fmt.Errorf("syslog network [%s] is not supported", network)
+	}
+	if sc.TLS && network != "tcp" {
+		return nil, fmt.Errorf("syslog TLS requires the tcp network")
+	}
+
+	facility, err := parseSyslogFacility(sc.Facility)
+	if err != nil {
+		return nil, err
+	}
+
+	var tlsConfig *tls.Config
+	if sc.TLS {
+		tlsConfig = sc.TLSConfig
+		if tlsConfig == nil {
+			tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+	}
+
+	timeout := sc.WriteTimeout
+	if timeout == 0 {
+		timeout = 5 * time.Second
+	}
+
+	hostname := sc.Hostname
+	if hostname == "" {
+		hostname, _ = os.Hostname()
+	}
+
+	appName := sc.Ident
+	if appName == "" {
+		appName = config.AppName
+	}
+
+	sdID := sc.StructuredDataID
+	if sdID == "" {
+		sdID = defaultSyslogSDID
+	}
+
+	bufferSize := sc.BufferSize
+	if bufferSize == 0 {
+		bufferSize = defaultSyslogBufferSize
+	}
+
+	d := &RFC5424Driver{
+		network:    network,
+		address:    sc.Address,
+		tlsConfig:  tlsConfig,
+		timeout:    timeout,
+		facility:   facility,
+		hostname:   syslogHeaderField(hostname, 255),
+		appName:    syslogHeaderField(appName, 48),
+		procID:     strconv.Itoa(os.Getpid()),
+		sdID:       syslogSDName(sdID),
+		bufferSize: bufferSize,
+		wake:       make(chan struct{}, 1),
+		quit:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+
+	// Connect eagerly but tolerate an unreachable server
+	d.connMu.Lock()
+	_ = d.connect()
+	d.connMu.Unlock()
+
+	go d.run()
+
+	return d, nil
+}
+
+// Log buffers a log entry for delivery by the background sender. When the
+// buffer is full the oldest message is dropped and reported to the error callback.
+func (d *RFC5424Driver) Log(entry *Entry) error {
+	msg := &syslogMessage{entry: entry, data: d.format(entry)}
+
+	d.mu.Lock()
+	if d.closed {
+		d.mu.Unlock()
+		return fmt.Errorf("syslog driver is closed")
+	}
+
+	var dropped *syslogMessage
+	if len(d.buffer) >= d.bufferSize {
+		dropped = d.buffer[0]
+		d.buffer = d.buffer[1:]
+	}
+	d.buffer = append(d.buffer, msg)
+	d.mu.Unlock()
+
+	if dropped != nil {
+		d.reportError(dropped.entry, errSyslogBufferFull)
+	}
+
+	select {
+	case d.wake <- struct{}{}:
+	default:
+		// The sender is already due to run
+	}
+	return nil
+}
+
+// run delivers buffered messages until Close, retrying every
+// syslogReconnectInterval while the server is unreachable
+func (d *RFC5424Driver) run() {
+	defer close(d.done)
+
+	for {
+		select {
+		case <-d.wake:
+		case <-d.quit:
+			return
+		}
+
+		for d.flush() != nil {
+			select {
+			case <-time.After(syslogReconnectInterval):
+			case <-d.quit:
+				return
+			}
+		}
+	}
+}
+
+// flush delivers buffered messages in order, stopping at the first failure.
+// A message that failed is put back at the front of the buffer, or dropped and
+// reported if Log filled the buffer meanwhile. Only the sender, or Close once
+// it stopped, calls flush.
+func (d *RFC5424Driver) flush() error {
+	for {
+		d.mu.Lock()
+		if len(d.buffer) == 0 {
+			d.mu.Unlock()
+			return nil
+		}
+		msg := d.buffer[0]
+		d.buffer = d.buffer[1:]
+		d.mu.Unlock()
+
+		if err := d.send(msg.data); err != nil {
+			d.mu.Lock()
+			requeued := len(d.buffer) < d.bufferSize
+			if requeued {
+				d.buffer = append([]*syslogMessage{msg}, d.buffer...)
+			}
+			d.mu.Unlock()
+
+			if !requeued {
+				d.reportError(msg.entry, errSyslogBufferFull)
+			}
+			return err
+		}
+	}
+}
+
+// send writes one message, reconnecting once if the connection broke
+func (d *RFC5424Driver) send(msg []byte) error {
+	d.connMu.Lock()
+	defer d.connMu.Unlock()
+
+	var err error
+	for attempt := 0; attempt < 2; attempt++ {
+		if d.conn == nil {
+			if err = d.connect(); err != nil {
+				return err
+			}
+		}
+		if err = d.write(msg); err == nil {
+			return nil
+		}
+		d.conn.Close()
+		d.conn = nil
+	}
+	return err
+}
+
+// reportError passes a delivery error to the error callback, if any
+func (d *RFC5424Driver) reportError(entry *Entry, err error) {
+	d.mu.Lock()
+	onError := d.onError
+	d.mu.Unlock()
+
+	if onError != nil {
+		onError(entry, err)
+	}
+}
+
+// SetErrorCallback sets the function receiving entries that were dropped: on
+// buffer overflow, or still undelivered when the driver is closed. Failed
+// deliveries are retried, not reported, so each entry is reported at most once.
+func (d *RFC5424Driver) SetErrorCallback(fn func(entry *Entry, err error)) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.onError = fn
+}
+
+// connect dials the server. The caller must hold d.connMu.
+func (d *RFC5424Driver) connect() error {
+	dialer := &net.Dialer{Timeout: d.timeout}
+
+	var conn net.Conn
+	var err error
+	if d.tlsConfig != nil {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: d.tlsConfig}).Dial(d.network, d.address)
+	} else {
+		conn, err = dialer.Dial(d.network, d.address)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to connect to syslog: %w", err)
+	}
+
+	d.conn = conn
+	return nil
+}
+
+// write sends one message, with octet-counting framing over TCP (RFC 6587).
+// The caller must hold d.connMu.
+func (d *RFC5424Driver) write(msg []byte) error {
+	if err := d.conn.SetWriteDeadline(time.Now().Add(d.timeout)); err != nil {
+		return err
+	}
+
+	if d.network == "tcp" {
+		msg = append([]byte(strconv.Itoa(len(msg))+" "), msg...)
+	}
+
+	_, err := d.conn.Write(msg)
+	return err
+}
+
+// format builds the RFC 5424 message for an entry:
+//
+//	<PRI>1 TIMESTAMP HOSTNAME APP-NAME PROCID MSGID [SD-ID param="value"...] MSG
+func (d *RFC5424Driver) format(entry *Entry) []byte {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "<%d>1 %s %s %s %s %s ",
+		d.facility*8+entry.Level.SyslogSeverity(),
+		entry.Timestamp.Format(rfc5424TimeFormat),
+		d.hostname,
+		d.appName,
+		d.procID,
+		syslogHeaderField(entry.Channel, 32),
+	)
+
+	b.WriteString(d.structuredData(entry))
+
+	if entry.Message != "" {
+		b.WriteByte(' ')
+		b.WriteString(entry.Message)
+	}
+
+	return []byte(b.String())
+}
+
+// structuredData renders the entry context and exception as one SD-ELEMENT
+func (d *RFC5424Driver) structuredData(entry *Entry) string {
+	params := make(map[string]string)
+	flattenLogfmt(params, "", entry.Context)
+	flattenLogfmt(params, "extra", entry.Extra)
+
+	if c := entry.Caller; c != nil {
+		params["caller"] = fmt.Sprintf("%s:%d", c.File, c.Line)
+	}
+
+	if ex := entry.Exception; ex != nil {
+		params["exception.class"] = ex.Class
+		params["exception.message"] = ex.Message
+		if ex.File != "" {
+			params["exception.file"] = fmt.Sprintf("%s:%d", ex.File, ex.Line)
+		}
+	}
+
+	if len(params) == 0 {
+		return "-"
+	}
+
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteByte('[')
+	b.WriteString(d.sdID)
+	for _, k := range keys {
+		b.WriteByte(' ')
+		b.WriteString(syslogSDName(k))
+		b.WriteString(`="`)
+		b.WriteString(sdParamEscaper.Replace(params[k]))
+		b.WriteByte('"')
+	}
+	b.WriteByte(']')
+	return b.String()
+}
+
+// sdParamEscaper escapes the characters RFC 5424 reserves in PARAM-VALUE
+var sdParamEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
+
+// syslogHeaderField restricts a header field to printable US-ASCII without
+// spaces and at most max characters; empty values become the NILVALUE "-"
+func syslogHeaderField(s string, max int) string {
+	s = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return -1
+		}
+		return r
+	}, s)
+	if len(s) > max {
+		s = s[:max]
+	}
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// syslogSDName sanitizes an SD-ID or PARAM-NAME: up to 32 printable
+// US-ASCII characters excluding '=', ' ', ']' and '"'
+func syslogSDName(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 || r == '=' || r == ']' || r == '"' {
+			return '_'
+		}
+		return r
+	}, s)
+	if len(s) > 32 {
+		s = s[:32]
+	}
+	if s == "" {
+		return "_"
+	}
+	return s
+}
+
+// Close stops the sender, makes a last attempt to deliver buffered messages
+// and closes the connection
+func (d *RFC5424Driver) Close() error {
+	d.mu.Lock()
+	if d.closed {
+		d.mu.Unlock()
+		return nil
+	}
+	d.closed = true
+	d.mu.Unlock()
+
+	close(d.quit)
+	<-d.done
+
+	_ = d.flush()
+
+	d.connMu.Lock()
+	var err error
+	if d.conn != nil {
+		err = d.conn.Close()
+		d.conn = nil
+	}
+	d.connMu.Unlock()
+
+	d.mu.Lock()
+	undelivered := d.buffer
+	d.buffer = nil
+	d.mu.Unlock()
+
+	if n := len(undelivered); n > 0 {
+		for _, msg := range undelivered {
+			d.reportError(msg.entry, errSyslogUndelivered)
+		}
+		return fmt.Errorf("%d syslog messages were not delivered", n)
+	}
+	return err
+}
+
+// Name returns the driver name
+func (d *RFC5424Driver) Name() string {
+	return "rfc5424"
+}
@@ -0,0 +1,318 @@
+package golog
+
+import (
+	"bufio"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// syslogServer accepts TCP connections and collects octet-framed messages
+type syslogServer struct {
+	ln       net.Listener
+	messages chan string
+	conns    chan net.Conn
+}
+
+func newSyslogServer(t *testing.T, ln net.Listener) *syslogServer {
+	t.Helper()
+
+	s := &syslogServer{
+		ln:       ln,
+		messages: make(chan string, 100),
+		conns:    make(chan net.Conn, 10),
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			s.conns <- conn
+			go s.read(conn)
+		}
+	}()
+	return s
+}
+
+func (s *syslogServer) read(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		prefix, err := r.ReadString(' ')
+		if err != nil {
+			return
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(prefix))
+		if err != nil {
+			return
+		}
+		buf := make([]byte, n)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return
+		}
+		s.messages <- string(buf)
+	}
+}
+
+func (s *syslogServer) next(t *testing.T) string {
+	t.Helper()
+	select {
+	case msg := <-s.messages:
+		return msg
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timed out waiting for syslog message")
+		return ""
+	}
+}
+
+func newTestRFC5424Driver(t *testing.T, address string, configure ...func(*SyslogConfig)) *RFC5424Driver {
+	t.Helper()
+
+	sc := &SyslogConfig{
+		Network:  "tcp",
+		Address:  address,
+		Facility: "local0",
+		Ident:    "shop",
+		Hostname: "web-01",
+	}
+	for _, fn := range configure {
+		fn(sc)
+	}
+
+	driver, err := NewRFC5424Driver(ChannelConfig{Driver: "rfc5424", SyslogConfig: sc})
+	if err != nil {
+		t.Fatalf("NewRFC5424Driver failed: %v", err)
+	}
+	return driver.(*RFC5424Driver)
+}
+
+func TestNewRFC5424Driver_Validation(t *testing.T) {
+	tests := []struct {
+		name   string
+		config ChannelConfig
+	}{
+		{"no config", ChannelConfig{Driver: "rfc5424"}},
+		{"no address", ChannelConfig{SyslogConfig: &SyslogConfig{}}},
+		{"bad network", ChannelConfig{SyslogConfig: &SyslogConfig{Network: "unix", Address: "/dev/log"}}},
+		{"tls over udp", ChannelConfig{SyslogConfig: &SyslogConfig{Network: "udp", Address: "localhost:514", TLS: true}}},
+		{"bad facility", ChannelConfig{SyslogConfig: &SyslogConfig{Address: "localhost:514", Facility: "nope"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewRFC5424Driver(tt.config); err == nil {
+				t.Error("Expected error")
+			}
+		})
+	}
+}
+
+func TestRFC5424Driver_Format(t *testing.T) {
+	d := &RFC5424Driver{facility: 16, hostname: "web-01", appName: "shop", procID: "123", sdID: defaultSyslogSDID}
+
+	entry := NewEntry(ErrorLevel, "payment failed")
+	entry.Timestamp = time.Date(2024, 1, 15, 10, 30, 45, 123456789, time.UTC)
+	entry.SetChannel("payments")
+	entry.WithContext(map[string]any{
+		"order_id": 42,
+		"note":     `say "hi" [ok] \o/`,
+		"user":     map[string]any{"id": 7},
+	})
+
+	got := string(d.format(entry))
+	want := `<131>1 2024-01-15T10:30:45.123456Z web-01 shop 123 payments ` +
+		`[golog@32473 note="say \"hi\" [ok\] \\o/" order_id="42" user.id="7"] payment failed`
+	if got != want {
+		t.Errorf("format() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestRFC5424Driver_FormatNilValues(t *testing.T) {
+	d := &RFC5424Driver{facility: 1, hostname: "-", appName: "-", procID: "1", sdID: defaultSyslogSDID}
+
+	entry := NewEntry(DebugLevel, "")
+	entry.Timestamp = time.Date(2024, 1, 15, 10, 30, 45, 0, time.UTC)
+
+	got := string(d.format(entry))
+	want := "<15>1 2024-01-15T10:30:45.000000Z - - 1 - -"
+	if got != want {
+		t.Errorf("format() = %q, want %q", got, want)
+	}
+}
+
+func TestRFC5424Driver_Log(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	server := newSyslogServer(t, ln)
+
+	driver := newTestRFC5424Driver(t, ln.Addr().String())
+	defer driver.Close()
+
+	if driver.Name() != "rfc5424" {
+		t.Errorf("Expected driver name 'rfc5424', got %q", driver.Name())
+	}
+
+	for _, msg := range []string{"first", "second"} {
+		if err := driver.Log(NewEntry(InfoLevel, msg)); err != nil {
+			t.Fatalf("Log failed: %v", err)
+		}
+	}
+
+	for _, want := range []string{"first", "second"} {
+		msg := server.next(t)
+		if !strings.HasPrefix(msg, "<134>1 ") || !strings.Contains(msg, " web-01 shop ") || !strings.HasSuffix(msg, " "+want) {
+			t.Errorf("Unexpected message %q", msg)
+		}
+	}
+}
+
+func TestRFC5424Driver_Reconnect(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	server := newSyslogServer(t, ln)
+
+	driver := newTestRFC5424Driver(t, ln.Addr().String())
+	defer driver.Close()
+
+	driver.Log(NewEntry(InfoLevel, "before"))
+	server.next(t)
+
+	// Break the connection from the client side
+	driver.connMu.Lock()
+	driver.conn.Close()
+	driver.connMu.Unlock()
+
+	if err := driver.Log(NewEntry(InfoLevel, "after")); err != nil {
+		t.Fatalf("Log failed: %v", err)
+	}
+	if msg := server.next(t); !strings.HasSuffix(msg, " after") {
+		t.Errorf("Expected message after reconnect, got %q", msg)
+	}
+}
+
+func TestRFC5424Driver_BuffersWhileDisconnected(t *testing.T) {
+	// Reserve an address with nothing listening on it
+	closed, _ := net.Listen("tcp", "127.0.0.1:0")
+	deadAddr := closed.Addr().String()
+	closed.Close()
+
+	driver := newTestRFC5424Driver(t, deadAddr, func(sc *SyslogConfig) { sc.BufferSize = 2 })
+
+	reported := make(chan string, 10)
+	driver.SetErrorCallback(func(entry *Entry, err error) {
+		reported <- entry.Message + ": " + err.Error()
+	})
+
+	for _, msg := range []string{"one", "two", "three"} {
+		if err := driver.Log(NewEntry(InfoLevel, msg)); err != nil {
+			t.Fatalf("Log should buffer without error: %v", err)
+		}
+	}
+
+	// The overflow drops and reports the oldest entry, not the one just logged
+	select {
+	case msg := <-reported:
+		if msg != "one: syslog buffer full, dropped oldest message" {
+			t.Errorf("Expected dropped oldest entry to be reported, got %q", msg)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timed out waiting for dropped entry")
+	}
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	server := newSyslogServer(t, ln)
+
+	driver.connMu.Lock()
+	driver.address = ln.Addr().String()
+	driver.connMu.Unlock()
+
+	if err := driver.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+
+	for _, want := range []string{"two", "three"} {
+		if msg := server.next(t); !strings.HasSuffix(msg, " "+want) {
+			t.Errorf("Expected buffered %q, got %q", want, msg)
+		}
+	}
+	if len(reported) != 0 {
+		t.Errorf("Expected delivered entries not to be reported, got %q", <-reported)
+	}
+}
+
+func TestRFC5424Driver_ReportsUndeliveredOnClose(t *testing.T) {
+	closed, _ := net.Listen("tcp", "127.0.0.1:0")
+	deadAddr := closed.Addr().String()
+	closed.Close()
+
+	driver := newTestRFC5424Driver(t, deadAddr)
+
+	reported := make(chan string, 10)
+	driver.SetErrorCallback(func(entry *Entry, err error) {
+		reported <- entry.Message
+	})
+
+	if err := driver.Log(NewEntry(InfoLevel, "lost")); err != nil {
+		t.Fatalf("Log should buffer without error: %v", err)
+	}
+
+	// Failed deliveries are retried, not reported
+	select {
+	case msg := <-reported:
+		t.Errorf("Expected no report while retrying, got %q", msg)
+	case <-time.After(syslogReconnectInterval + 200*time.Millisecond):
+	}
+
+	if err := driver.Close(); err == nil || !strings.Contains(err.Error(), "1 syslog messages were not delivered") {
+		t.Errorf("Expected undelivered message on Close, got %v", err)
+	}
+	if len(reported) != 1 || <-reported != "lost" {
+		t.Errorf("Expected the undelivered entry to be reported once on Close")
+	}
+}
+
+func TestRFC5424Driver_TLS(t *testing.T) {
+	tlsServer := httptest.NewUnstartedServer(nil)
+	tlsServer.StartTLS()
+	cert := tlsServer.TLS.Certificates[0]
+	tlsServer.Close()
+
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{cert}})
+	if err != nil {
+		t.Fatalf("tls.Listen failed: %v", err)
+	}
+	server := newSyslogServer(t, ln)
+
+	pool := x509.NewCertPool()
+	leaf, _ := x509.ParseCertificate(cert.Certificate[0])
+	pool.AddCert(leaf)
+
+	driver := newTestRFC5424Driver(t, ln.Addr().String(), func(sc *SyslogConfig) {
+		sc.TLS = true
+		sc.TLSConfig = &tls.Config{RootCAs: pool, ServerName: "example.com"}
+	})
+	defer driver.Close()
+
+	if err := driver.Log(NewEntry(WarningLevel, "secure")); err != nil {
+		t.Fatalf("Log failed: %v", err)
+	}
+	if msg := server.next(t); !strings.HasSuffix(msg, " secure") {
+		t.Errorf("Unexpected message %q", msg)
+	}
+}